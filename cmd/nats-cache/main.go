@@ -6,12 +6,17 @@ import (
 	"connectrpc.com/grpcreflect"
 	"connectrpc.com/otelconnect"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"github.com/jasonmccallister/nats-cache/internal/auth"
 	"github.com/jasonmccallister/nats-cache/internal/cached"
 	"github.com/jasonmccallister/nats-cache/internal/embeddednats"
+	"github.com/jasonmccallister/nats-cache/internal/gateway"
 	"github.com/jasonmccallister/nats-cache/internal/gen/cache/v1/cachev1connect"
 	"github.com/jasonmccallister/nats-cache/internal/localbucket"
+	"github.com/jasonmccallister/nats-cache/internal/memcache"
+	"github.com/jasonmccallister/nats-cache/internal/natsservice"
+	"github.com/jasonmccallister/nats-cache/internal/resp"
 	"github.com/jasonmccallister/nats-cache/internal/storage"
 	"github.com/jasonmccallister/nats-cache/logs"
 	"github.com/nats-io/nats.go"
@@ -22,9 +27,13 @@ import (
 	"net/http"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
+// objectSweepInterval is how often objects that are no longer linked from a key are removed
+const objectSweepInterval = 10 * time.Minute
+
 func main() {
 	ctx := context.Background()
 
@@ -70,30 +79,166 @@ func main() {
 		os.Exit(1)
 	}
 
+	// the object store uses the legacy jetstream api since the new api does not support object stores yet
+	legacy, err := nc.JetStream()
+	if err != nil {
+		logger.ErrorContext(ctx, fmt.Errorf("failed to create jetstream context: %w", err).Error())
+		os.Exit(1)
+	}
+
+	obs, err := localbucket.CreateObjectStoreFromEnv(legacy)
+	if err != nil {
+		logger.ErrorContext(ctx, fmt.Errorf("failed to create local object store: %w", err).Error())
+		os.Exit(1)
+	}
+
 	authorizer, err := auth.NewFromEnvironment()
 	if err != nil {
 		logger.ErrorContext(ctx, fmt.Errorf("failed to create authorizer: %w", err).Error())
 		os.Exit(1)
 	}
 
-	if err := run(ctx, logger, authorizer, kv); err != nil {
+	if err := run(ctx, logger, authorizer, nc, kv, obs); err != nil {
 		logger.ErrorContext(ctx, fmt.Errorf("failed to run server: %w", err).Error())
 		os.Exit(2)
 	}
 }
 
-func run(ctx context.Context, logger *slog.Logger, authorizer auth.Authorizer, kv jetstream.KeyValue) error {
+func run(ctx context.Context, logger *slog.Logger, authorizer auth.Authorizer, nc *nats.Conn, kv jetstream.KeyValue, obs nats.ObjectStore) error {
 	store := storage.NewNATSKeyValue(kv, logger)
+	objects := storage.NewNATSObjectStore(obs, logger)
+
+	// encrypt values with per subject keys when master keys (base64, oldest first) are configured
+	if v, ok := os.LookupEnv("STORAGE_ENCRYPTION_KEYS"); ok && v != "" {
+		var masters [][]byte
+		for _, k := range strings.Split(v, ",") {
+			b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(k))
+			if err != nil {
+				return fmt.Errorf("failed to decode encryption key: %w", err)
+			}
+
+			masters = append(masters, b)
+		}
+
+		encrypted, err := storage.NewEncrypted(store, logger, masters)
+		if err != nil {
+			return fmt.Errorf("failed to create encrypted store: %w", err)
+		}
+
+		store = encrypted
+		objects = encrypted.Objects(objects)
+
+		logger.InfoContext(ctx, "encrypting values", "key_version", len(masters))
+
+		// values written with an older key are rewritten in the background after a rotation
+		go func() {
+			start := time.Now()
+			n, err := encrypted.Reencrypt(ctx)
+			if err != nil {
+				logger.ErrorContext(ctx, "failed to re-encrypt values", "error", err.Error())
+				return
+			}
+
+			logger.InfoContext(ctx, "re-encrypted values", "count", n, "duration", time.Since(start).String())
+		}()
+	}
+
+	// compress large values when a codec (s2 or zstd) is configured
+	if v, ok := os.LookupEnv("STORAGE_COMPRESSION"); ok && v != "" {
+		threshold := storage.DefaultCompressionThreshold
+		if t, ok := os.LookupEnv("STORAGE_COMPRESSION_THRESHOLD"); ok {
+			n, err := strconv.Atoi(t)
+			if err != nil {
+				return fmt.Errorf("failed to parse compression threshold: %w", err)
+			}
+
+			threshold = n
+		}
+
+		var err error
+		store, err = storage.NewCompressed(store, logger, storage.Codec(v), threshold)
+		if err != nil {
+			return fmt.Errorf("failed to create compressed store: %w", err)
+		}
+
+		objects, err = storage.NewCompressedObjects(objects, logger, storage.Codec(v))
+		if err != nil {
+			return fmt.Errorf("failed to create compressed object store: %w", err)
+		}
+
+		logger.InfoContext(ctx, "compressing values", "codec", v, "threshold", threshold)
+	}
 
 	var opts []connect.HandlerOption
 	opts = append(opts, connect.WithInterceptors(otelconnect.NewInterceptor()))
-	server := cached.NewServer(logger, authorizer, store)
+
+	// remove objects of keys that expired or were overwritten without removing the object
+	go func() {
+		for range time.Tick(objectSweepInterval) {
+			n, err := storage.SweepObjects(ctx, store, objects, objectSweepInterval)
+			if err != nil {
+				logger.ErrorContext(ctx, "failed to sweep objects", "error", err.Error())
+				continue
+			}
+
+			logger.DebugContext(ctx, "swept objects", "count", n)
+		}
+	}()
+
+	// the protocol listeners write keys the same way as the cache service
+	keys := cached.NewKeyspace(logger, store, objects)
+
+	// the redis protocol listener is only started when a port is configured
+	if v, ok := os.LookupEnv("RESP_PORT"); ok {
+		p, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("failed to parse resp port: %w", err)
+		}
+
+		go func() {
+			logger.InfoContext(ctx, "starting redis protocol server", "port", p)
+
+			if err := resp.NewServer(logger, authorizer, keys).ListenAndServe(ctx, fmt.Sprintf(":%d", p)); err != nil {
+				logger.ErrorContext(ctx, "failed to run redis protocol server", "error", err.Error())
+			}
+		}()
+	}
+
+	// the memcached protocol listener is only started when a port is configured, clients use the default
+	// subject when one is configured and otherwise authenticate with a token
+	if v, ok := os.LookupEnv("MEMCACHE_PORT"); ok {
+		p, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("failed to parse memcache port: %w", err)
+		}
+
+		subject := os.Getenv("MEMCACHE_DEFAULT_SUBJECT")
+
+		go func() {
+			logger.InfoContext(ctx, "starting memcached protocol server", "port", p, "default_subject", subject != "")
+
+			if err := memcache.NewServer(logger, authorizer, keys, subject).ListenAndServe(ctx, fmt.Sprintf(":%d", p)); err != nil {
+				logger.ErrorContext(ctx, "failed to run memcached protocol server", "error", err.Error())
+			}
+		}()
+	}
+
+	server := cached.NewServer(logger, authorizer, store, objects)
+	lockServer := cached.NewLockServer(logger, authorizer, store)
+
+	// expose the cache service over nats request and reply using the same handlers
+	if _, err := natsservice.New(ctx, nc, logger, server); err != nil {
+		return fmt.Errorf("failed to create nats service: %w", err)
+	}
+
+	logger.InfoContext(ctx, "registered nats service", "service", natsservice.Name, "subject", natsservice.Prefix+".>")
 
 	// create the services
 	cachePath, cacheHandler := cachev1connect.NewCacheServiceHandler(server, opts...)
-	healthCheck, healthCheckHandler := grpchealth.NewHandler(grpchealth.NewStaticChecker(cachev1connect.CacheServiceName))
+	lockPath, lockHandler := cachev1connect.NewLockServiceHandler(lockServer, opts...)
+	healthCheck, healthCheckHandler := grpchealth.NewHandler(grpchealth.NewStaticChecker(cachev1connect.CacheServiceName, cachev1connect.LockServiceName))
 	reflectPath, reflectHandler := grpcreflect.NewHandlerV1Alpha(
-		grpcreflect.NewStaticReflector(cachev1connect.CacheServiceName, grpchealth.HealthV1ServiceName),
+		grpcreflect.NewStaticReflector(cachev1connect.CacheServiceName, cachev1connect.LockServiceName, grpchealth.HealthV1ServiceName),
 	)
 
 	mux := http.NewServeMux()
@@ -106,6 +251,14 @@ func run(ctx context.Context, logger *slog.Logger, authorizer auth.Authorizer, k
 	logger.InfoContext(ctx, "registering cache service", "service", cachev1connect.CacheServiceName, "path", cachePath)
 	mux.Handle(cachePath, cacheHandler)
 
+	// register the lock service
+	logger.InfoContext(ctx, "registering lock service", "service", cachev1connect.LockServiceName, "path", lockPath)
+	mux.Handle(lockPath, lockHandler)
+
+	// register the REST gateway, it uses the cache service so requests are handled the same way
+	logger.InfoContext(ctx, "registering rest gateway", "path", gateway.Path)
+	mux.Handle(gateway.Path, gateway.NewHandler(logger, server))
+
 	// register the health service
 	logger.InfoContext(ctx, "registering health check", "service", cachev1connect.CacheServiceName, "path", healthCheck)
 	mux.Handle(healthCheck, healthCheckHandler)
@@ -0,0 +1,142 @@
+package cached
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/keygen"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+// GetAndDelete returns the value of the key and deletes it. If two clients consume the same key only
+// one of them receives the value. Values stored with PutObject are not deleted since the value can not be
+// returned.
+func (s *server) GetAndDelete(ctx context.Context, req *connect.Request[cachev1.GetAndDeleteRequest]) (*connect.Response[cachev1.GetAndDeleteResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.FromToken(*t, req.Msg.GetDatabase(), req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	start := time.Now()
+
+	// the key is deleted at the revision it was read at so only one client receives the value
+	var e *storage.Entry
+	for {
+		e, err = s.Store.Get(ctx, internalKey)
+		if err != nil || e == nil {
+			break
+		}
+
+		if e.Object != "" {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errObject)
+		}
+
+		_, err = s.remove(ctx, *t, req.Msg.GetDatabase(), internalKey, e.Revision)
+		if !errors.Is(err, storage.ErrRevisionMismatch) {
+			break
+		}
+	}
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to get and delete key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get and delete key: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "get and delete", "key", internalKey, "duration", time.Since(start).String())
+
+	resp := &cachev1.GetAndDeleteResponse{
+		Key: req.Msg.GetKey(),
+	}
+
+	if e != nil {
+		resp.Value = e.Value
+		resp.Ttl = e.TTL
+		resp.Deleted = true
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// GetAndSet stores the value and returns the previous value of the key. A value stored with PutObject is
+// not replaced since the previous value can not be returned.
+func (s *server) GetAndSet(ctx context.Context, req *connect.Request[cachev1.GetAndSetRequest]) (*connect.Response[cachev1.GetAndSetResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.FromToken(*t, req.Msg.GetDatabase(), req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	// did the user provide a value for the ttl?
+	var ttl int64
+	if req.Msg.GetTtl() > 0 {
+		ttl = time.Now().Add(time.Duration(req.Msg.GetTtl()) * time.Second).Unix()
+	}
+
+	i := storage.Item{
+		Value: req.Msg.GetValue(),
+		TTL:   ttl,
+	}
+
+	start := time.Now()
+
+	// the value is written at the revision the previous value was read at so no write is lost
+	var (
+		e        *storage.Entry
+		revision uint64
+	)
+	for {
+		e, err = s.Store.Get(ctx, internalKey)
+		if err != nil {
+			break
+		}
+
+		if e != nil && e.Object != "" {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errObject)
+		}
+
+		var current uint64
+		if e != nil {
+			current = e.Revision
+		}
+
+		revision, err = s.write(ctx, *t, req.Msg.GetDatabase(), internalKey, i, cachev1.SetCondition_SET_CONDITION_IF_REVISION, current)
+		if !errors.Is(err, storage.ErrRevisionMismatch) && !errors.Is(err, storage.ErrKeyExists) {
+			break
+		}
+	}
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to get and set key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get and set key: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "get and set", "key", internalKey, "duration", time.Since(start).String())
+
+	resp := &cachev1.GetAndSetResponse{
+		Key:      req.Msg.GetKey(),
+		Revision: revision,
+	}
+
+	if e != nil {
+		resp.Value = e.Value
+		resp.Ttl = e.TTL
+		resp.Exists = true
+	}
+
+	return connect.NewResponse(resp), nil
+}
@@ -2,6 +2,7 @@ package cached
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
@@ -16,8 +17,8 @@ import (
 
 type server struct {
 	Authorizer auth.Authorizer
-	Store      storage.Store
-	Logger     *slog.Logger
+
+	*Keyspace
 
 	cachev1connect.UnimplementedCacheServiceHandler
 }
@@ -36,7 +37,7 @@ func (s *server) Get(ctx context.Context, req *connect.Request[cachev1.GetReques
 	}
 
 	start := time.Now()
-	value, ttl, err := s.Store.Get(ctx, internalKey)
+	e, err := s.get(ctx, internalKey, req.Msg)
 	if err != nil {
 		s.Logger.ErrorContext(ctx, "failed to get key", "error", err.Error())
 		return nil, err
@@ -44,11 +45,46 @@ func (s *server) Get(ctx context.Context, req *connect.Request[cachev1.GetReques
 
 	s.Logger.DebugContext(ctx, "get", "key", internalKey, "duration", time.Since(start).String())
 
-	return connect.NewResponse(&cachev1.GetResponse{
-		Key:   req.Msg.GetKey(),
-		Value: value,
-		Ttl:   ttl,
-	}), nil
+	if e != nil && e.Object != "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errObject)
+	}
+
+	resp := &cachev1.GetResponse{
+		Key: req.Msg.GetKey(),
+	}
+
+	if e != nil {
+		resp.Value = e.Value
+		resp.Ttl = e.TTL
+		resp.Revision = e.Revision
+		resp.Freshness = freshness(e)
+		resp.SoftTtl = e.SoftTTL
+		resp.ContentType = e.ContentType
+		resp.ContentEncoding = e.ContentEncoding
+		resp.Metadata = e.Metadata
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// get returns the entry for the request, either the latest entry or the entry at the revision in the
+// request. Reading the latest entry refreshes a sliding expiration and the entry has the new ttl and
+// revision.
+func (s *server) get(ctx context.Context, internalKey string, req *cachev1.GetRequest) (*storage.Entry, error) {
+	if req.Revision != nil {
+		return s.Store.GetRevision(ctx, internalKey, req.GetRevision())
+	}
+
+	return s.read(ctx, internalKey)
+}
+
+// freshness returns whether the value of the entry is fresh or stale.
+func freshness(e *storage.Entry) cachev1.Freshness {
+	if e.IsStale() {
+		return cachev1.Freshness_FRESHNESS_STALE
+	}
+
+	return cachev1.Freshness_FRESHNESS_FRESH
 }
 
 func (s *server) GetMulti(ctx context.Context, req *connect.Request[cachev1.GetMultiRequest]) (*connect.Response[cachev1.GetMultiResponse], error) {
@@ -68,16 +104,28 @@ func (s *server) GetMulti(ctx context.Context, req *connect.Request[cachev1.GetM
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
 		}
 
-		value, ttl, err := s.Store.Get(ctx, internalKey)
+		e, err := s.read(ctx, internalKey)
 		if err != nil {
 			s.Logger.ErrorContext(ctx, "failed to get key", "error", err.Error())
 			return nil, err
 		}
 
 		items[i] = &cachev1.Item{
-			Key:   k,
-			Value: value,
-			Ttl:   ttl,
+			Key: k,
+		}
+
+		switch {
+		case e != nil && e.Object != "":
+			items[i].Error = errObject.Error()
+		case e != nil:
+			items[i].Value = e.Value
+			items[i].Ttl = e.TTL
+			items[i].Revision = e.Revision
+			items[i].Freshness = freshness(e)
+			items[i].SoftTtl = e.SoftTTL
+			items[i].ContentType = e.ContentType
+			items[i].ContentEncoding = e.ContentEncoding
+			items[i].Metadata = e.Metadata
 		}
 
 		s.Logger.DebugContext(ctx, "get", "key", internalKey, "duration", time.Since(start).String())
@@ -101,33 +149,95 @@ func (s *server) Set(ctx context.Context, req *connect.Request[cachev1.SetReques
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
 	}
 
-	// did the user provide a value for the ttl?
-	var ttl int64
-	if req.Msg.GetTtl() > 0 {
-		ttl = time.Now().Add(time.Duration(req.Msg.GetTtl()) * time.Second).Unix()
-	}
-
 	start := time.Now()
-	if err := s.Store.Set(ctx, internalKey, req.Msg.GetValue(), ttl); err != nil {
-		s.Logger.ErrorContext(ctx, "failed to set key", "error", err.Error())
+	revision, ttl, err := s.set(ctx, *t, internalKey, req.Msg)
+	if err != nil {
 		return nil, err
 	}
 
 	s.Logger.DebugContext(ctx, "set", "key", internalKey, "duration", time.Since(start).String())
 
 	return connect.NewResponse(&cachev1.SetResponse{
-		Key:   req.Msg.GetKey(),
-		Value: req.Msg.GetValue(),
-		Ttl:   ttl,
+		Key:      req.Msg.GetKey(),
+		Value:    req.Msg.GetValue(),
+		Ttl:      ttl,
+		Revision: revision,
 	}), nil
 }
 
-// NewServer returns a new server for the cache service.
-func NewServer(l *slog.Logger, a auth.Authorizer, s storage.Store) cachev1connect.CacheServiceHandler {
+// set stores the value from the request using the condition in the request and returns the new revision
+// and the ttl in unix time. The key is added to the index of its tags after it is stored. Errors are
+// returned as connect errors.
+func (s *server) set(ctx context.Context, t auth.Token, internalKey string, req *cachev1.SetRequest) (uint64, int64, error) {
+	// did the user provide a value for the ttl?
+	var ttl int64
+	if req.GetTtl() > 0 {
+		ttl = time.Now().Add(time.Duration(req.GetTtl()) * time.Second).Unix()
+	}
+
+	i := storage.Item{
+		Value:           req.GetValue(),
+		TTL:             ttl,
+		ContentType:     req.GetContentType(),
+		ContentEncoding: req.GetContentEncoding(),
+		Metadata:        req.GetMetadata(),
+	}
+
+	if req.GetSoftTtl() > 0 {
+		if req.GetTtl() > 0 && req.GetSoftTtl() > req.GetTtl() {
+			return 0, 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("soft_ttl cannot be greater than the ttl"))
+		}
+
+		i.SoftTTL = time.Now().Add(time.Duration(req.GetSoftTtl()) * time.Second).Unix()
+	}
+
+	if req.GetSliding() {
+		if ttl == 0 {
+			return 0, 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("ttl is required for sliding expiration"))
+		}
+
+		i.Sliding = int64(req.GetTtl())
+	}
+
+	for _, tag := range req.GetTags() {
+		if tag == "" {
+			return 0, 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("tags cannot be empty"))
+		}
+
+		if !i.HasTag(tag) {
+			i.Tags = append(i.Tags, tag)
+		}
+	}
+
+	if req.GetCondition() == cachev1.SetCondition_SET_CONDITION_IF_REVISION && req.Revision == nil {
+		return 0, 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("revision is required for the condition"))
+	}
+
+	revision, err := s.write(ctx, t, req.GetDatabase(), internalKey, i, req.GetCondition(), req.GetRevision())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to set key", "condition", req.GetCondition().String(), "error", err.Error())
+
+		switch {
+		case errors.Is(err, storage.ErrKeyExists):
+			return 0, 0, connect.NewError(connect.CodeAlreadyExists, err)
+		case errors.Is(err, storage.ErrKeyNotFound), errors.Is(err, storage.ErrRevisionMismatch):
+			return 0, 0, connect.NewError(connect.CodeFailedPrecondition, err)
+		case errors.Is(err, storage.ErrValueTooLarge):
+			return 0, 0, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("%w, use PutObject for large values", err))
+		}
+
+		return 0, 0, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to set key: %w", err))
+	}
+
+	return revision, ttl, nil
+}
+
+// NewServer returns a new server for the cache service. Values that are too large for the store are
+// kept in the object store.
+func NewServer(l *slog.Logger, a auth.Authorizer, s storage.Store, o storage.ObjectStore) cachev1connect.CacheServiceHandler {
 	return &server{
-		Logger:     l,
 		Authorizer: a,
-		Store:      s,
+		Keyspace:   NewKeyspace(l, s, o),
 	}
 }
 
@@ -147,7 +257,7 @@ func (s *server) Delete(ctx context.Context, req *connect.Request[cachev1.Delete
 	}
 
 	// maybe consider removing the db from the delete request and rely on a generic key?
-	if err := s.Store.Delete(ctx, internalKey); err != nil {
+	if _, err := s.remove(ctx, *t, req.Msg.GetDatabase(), internalKey, 0); err != nil {
 		s.Logger.ErrorContext(ctx, "failed to delete key", "error", err.Error())
 		return nil, err
 	}
@@ -177,13 +287,13 @@ func (s *server) Exists(ctx context.Context, req *connect.Request[cachev1.Exists
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
 		}
 
-		val, _, err := s.Store.Get(ctx, internalKey)
+		e, err := s.Store.Get(ctx, internalKey)
 		if err != nil {
 			s.Logger.ErrorContext(ctx, "failed to get key", "error", err.Error())
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get key: %w", err))
 		}
 
-		if val != nil {
+		if e != nil {
 			found = append(found, k)
 		}
 	}
@@ -217,13 +327,12 @@ func (s *server) GetStream(ctx context.Context, stream *connect.BidiStream[cache
 		}
 
 		start := time.Now()
-		value, ttl, err := s.Store.Get(ctx, internalKey)
+		e, err := s.get(ctx, internalKey, req)
 		if err != nil {
 			s.Logger.ErrorContext(ctx, "failed to get key", "error", err.Error())
 			err := stream.Send(&cachev1.GetResponse{
 				Key:   key,
-				Value: nil,
-				Ttl:   ttl,
+				Error: fmt.Sprintf("failed to get key: %s", err),
 			})
 			if err != nil {
 				return err
@@ -234,11 +343,25 @@ func (s *server) GetStream(ctx context.Context, stream *connect.BidiStream[cache
 
 		s.Logger.DebugContext(ctx, "get", "key", internalKey, "duration", time.Since(start).String())
 
-		if err := stream.Send(&cachev1.GetResponse{
-			Key:   key,
-			Value: value,
-			Ttl:   ttl,
-		}); err != nil {
+		resp := &cachev1.GetResponse{
+			Key: key,
+		}
+
+		switch {
+		case e != nil && e.Object != "":
+			resp.Error = errObject.Error()
+		case e != nil:
+			resp.Value = e.Value
+			resp.Ttl = e.TTL
+			resp.Revision = e.Revision
+			resp.Freshness = freshness(e)
+			resp.SoftTtl = e.SoftTTL
+			resp.ContentType = e.ContentType
+			resp.ContentEncoding = e.ContentEncoding
+			resp.Metadata = e.Metadata
+		}
+
+		if err := stream.Send(resp); err != nil {
 			s.Logger.ErrorContext(ctx, "failed to send response", "error", err.Error())
 			return err
 		}
@@ -270,23 +393,18 @@ func (s *server) SetStream(ctx context.Context, stream *connect.BidiStream[cache
 			return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
 		}
 
-		// did the user provide a value for the ttl?
-		var ttl int64
-		if req.GetTtl() > 0 {
-			ttl = time.Now().Add(time.Duration(req.GetTtl()) * time.Second).Unix()
-		}
-
-		if err := s.Store.Set(ctx, internalKey, req.GetValue(), ttl); err != nil {
-			s.Logger.ErrorContext(ctx, "failed to set key", "error", err.Error())
-			return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to set key: %w", err))
+		revision, ttl, err := s.set(ctx, *t, internalKey, req)
+		if err != nil {
+			return err
 		}
 
 		s.Logger.DebugContext(ctx, "set", "key", internalKey, "duration", time.Since(start).String())
 
 		if err := stream.Send(&cachev1.SetResponse{
-			Key:   key,
-			Value: req.GetValue(),
-			Ttl:   ttl,
+			Key:      key,
+			Value:    req.GetValue(),
+			Ttl:      ttl,
+			Revision: revision,
 		}); err != nil {
 			s.Logger.ErrorContext(ctx, "failed to send response", "error", err.Error())
 			return err
@@ -308,9 +426,9 @@ func (s *server) Purge(ctx context.Context, req *connect.Request[cachev1.PurgeRe
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
 	}
 
-	if err := s.Store.Purge(ctx, internalKey); err != nil {
+	if err := s.purge(ctx, internalKey); err != nil {
 		s.Logger.ErrorContext(ctx, "failed to purge keys", "error", err.Error())
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to purge keys: %w", err))
+		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
 	return connect.NewResponse(&cachev1.PurgeResponse{
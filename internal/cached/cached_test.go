@@ -0,0 +1,93 @@
+package cached
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jasonmccallister/nats-cache/internal/auth"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+	natsserver "github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// subjectAuthorizer uses the token as the subject and rejects the token "invalid".
+type subjectAuthorizer struct{}
+
+func (subjectAuthorizer) Authorize(token string) (*auth.Token, error) {
+	subject := strings.TrimPrefix(token, "Bearer ")
+	if subject == "" || subject == "invalid" {
+		return nil, fmt.Errorf("invalid token")
+	}
+
+	return &auth.Token{Subject: subject}, nil
+}
+
+func newServer(t *testing.T) *server {
+	t.Helper()
+
+	return NewServer(slog.New(slog.NewTextHandler(io.Discard, nil)), subjectAuthorizer{}, storage.NewInMemory(), storage.NewInMemoryObjects()).(*server)
+}
+
+// newNATSServer returns a server using a bucket on an embedded JetStream server, the bucket keeps 5
+// revisions of each key and validates keys the same way as in production.
+func newNATSServer(t *testing.T) *server {
+	t.Helper()
+
+	ns, err := natsserver.NewServer(&natsserver.Options{Port: -1, JetStream: true, StoreDir: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go ns.Start()
+	if !ns.ReadyForConnections(5 * time.Second) {
+		t.Fatal("nats server is not ready")
+	}
+	t.Cleanup(ns.Shutdown)
+
+	nc, err := nats.Connect(ns.ClientURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(nc.Close)
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kv, err := js.CreateKeyValue(context.TODO(), jetstream.KeyValueConfig{Bucket: "cache", History: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	return NewServer(l, subjectAuthorizer{}, storage.NewNATSKeyValue(kv, l), storage.NewInMemoryObjects()).(*server)
+}
+
+// request creates a request for the subject alice.
+func request[T any](msg *T) *connect.Request[T] {
+	req := connect.NewRequest(msg)
+	req.Header().Set("Authorization", "Bearer alice")
+
+	return req
+}
+
+func TestServer_Get_unauthenticated(t *testing.T) {
+	s := newServer(t)
+
+	req := connect.NewRequest(&cachev1.GetRequest{Key: "a"})
+	req.Header().Set("Authorization", "Bearer invalid")
+
+	if _, err := s.Get(context.TODO(), req); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("Get() error = %v, want %v", err, connect.CodeUnauthenticated)
+	}
+}
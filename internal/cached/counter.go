@@ -0,0 +1,101 @@
+package cached
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jasonmccallister/nats-cache/internal/auth"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/keygen"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+// Increment adds the delta (or 1 if not provided) to the integer stored at the key.
+func (s *server) Increment(ctx context.Context, req *connect.Request[cachev1.IncrementRequest]) (*connect.Response[cachev1.IncrementResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	delta := int64(1)
+	if req.Msg.Delta != nil {
+		delta = req.Msg.GetDelta()
+	}
+
+	value, ttl, err := s.increment(ctx, *t, req.Msg.GetDatabase(), req.Msg.GetKey(), delta, req.Msg.GetTtl())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&cachev1.IncrementResponse{
+		Key:   req.Msg.GetKey(),
+		Value: value,
+		Ttl:   ttl,
+	}), nil
+}
+
+// Decrement subtracts the delta (or 1 if not provided) from the integer stored at the key.
+func (s *server) Decrement(ctx context.Context, req *connect.Request[cachev1.DecrementRequest]) (*connect.Response[cachev1.DecrementResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	delta := int64(1)
+	if req.Msg.Delta != nil {
+		delta = req.Msg.GetDelta()
+	}
+
+	// the delta cannot be negated without overflowing
+	if delta == math.MinInt64 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("delta is out of range"))
+	}
+
+	value, ttl, err := s.increment(ctx, *t, req.Msg.GetDatabase(), req.Msg.GetKey(), -delta, req.Msg.GetTtl())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&cachev1.DecrementResponse{
+		Key:   req.Msg.GetKey(),
+		Value: value,
+		Ttl:   ttl,
+	}), nil
+}
+
+// increment is shared by Increment and Decrement and converts storage errors into connect errors.
+func (s *server) increment(ctx context.Context, t auth.Token, db uint32, key string, delta int64, seconds uint32) (int64, int64, error) {
+	internalKey, _, err := keygen.FromToken(t, db, key)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return 0, 0, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	// did the user provide a value for the ttl?
+	var ttl int64
+	if seconds > 0 {
+		ttl = time.Now().Add(time.Duration(seconds) * time.Second).Unix()
+	}
+
+	start := time.Now()
+	value, ttl, err := s.Store.Increment(ctx, internalKey, delta, ttl)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to increment key", "error", err.Error())
+
+		if errors.Is(err, storage.ErrNotInteger) || errors.Is(err, storage.ErrOverflow) {
+			return 0, 0, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+
+		return 0, 0, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to increment key: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "increment", "key", internalKey, "delta", delta, "duration", time.Since(start).String())
+
+	return value, ttl, nil
+}
@@ -0,0 +1,232 @@
+package cached
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/keygen"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+// HSet stores the fields in the hash at the key.
+func (s *server) HSet(ctx context.Context, req *connect.Request[cachev1.HSetRequest]) (*connect.Response[cachev1.HSetResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	if len(req.Msg.GetFields()) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at least one field is required"))
+	}
+
+	internalKey, _, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "hash", req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	// did the user provide a value for the ttl?
+	var ttl int64
+	if req.Msg.GetTtl() > 0 {
+		ttl = time.Now().Add(time.Duration(req.Msg.GetTtl()) * time.Second).Unix()
+	}
+
+	start := time.Now()
+	added, ttl, err := storage.HashSet(ctx, s.Store, internalKey, req.Msg.GetFields(), ttl)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to set hash fields", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to set hash fields: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "hset", "key", internalKey, "added", added, "duration", time.Since(start).String())
+
+	return connect.NewResponse(&cachev1.HSetResponse{
+		Key:   req.Msg.GetKey(),
+		Added: uint32(added),
+		Ttl:   ttl,
+	}), nil
+}
+
+// HGet returns the value of a field in the hash at the key.
+func (s *server) HGet(ctx context.Context, req *connect.Request[cachev1.HGetRequest]) (*connect.Response[cachev1.HGetResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "hash", req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	start := time.Now()
+	h, _, err := storage.HashGet(ctx, s.Store, internalKey)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to get hash", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get hash: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "hget", "key", internalKey, "duration", time.Since(start).String())
+
+	v, ok := h[req.Msg.GetField()]
+
+	return connect.NewResponse(&cachev1.HGetResponse{
+		Key:    req.Msg.GetKey(),
+		Field:  req.Msg.GetField(),
+		Value:  v,
+		Exists: ok,
+	}), nil
+}
+
+// HMGet returns the values of the fields in the hash at the key in the order they were requested.
+func (s *server) HMGet(ctx context.Context, req *connect.Request[cachev1.HMGetRequest]) (*connect.Response[cachev1.HMGetResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "hash", req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	start := time.Now()
+	h, _, err := storage.HashGet(ctx, s.Store, internalKey)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to get hash", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get hash: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "hmget", "key", internalKey, "duration", time.Since(start).String())
+
+	fields := make([]*cachev1.HashField, len(req.Msg.GetFields()))
+	for i, f := range req.Msg.GetFields() {
+		v, ok := h[f]
+
+		fields[i] = &cachev1.HashField{
+			Field:  f,
+			Value:  v,
+			Exists: ok,
+		}
+	}
+
+	return connect.NewResponse(&cachev1.HMGetResponse{
+		Key:    req.Msg.GetKey(),
+		Fields: fields,
+	}), nil
+}
+
+// HDel removes the fields from the hash at the key.
+func (s *server) HDel(ctx context.Context, req *connect.Request[cachev1.HDelRequest]) (*connect.Response[cachev1.HDelResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "hash", req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	start := time.Now()
+	deleted, err := storage.HashDelete(ctx, s.Store, internalKey, req.Msg.GetFields())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to delete hash fields", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete hash fields: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "hdel", "key", internalKey, "deleted", deleted, "duration", time.Since(start).String())
+
+	return connect.NewResponse(&cachev1.HDelResponse{
+		Key:     req.Msg.GetKey(),
+		Deleted: uint32(deleted),
+	}), nil
+}
+
+// HGetAll returns every field in the hash at the key.
+func (s *server) HGetAll(ctx context.Context, req *connect.Request[cachev1.HGetAllRequest]) (*connect.Response[cachev1.HGetAllResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "hash", req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	start := time.Now()
+	h, ttl, err := storage.HashGet(ctx, s.Store, internalKey)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to get hash", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get hash: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "hgetall", "key", internalKey, "count", len(h), "duration", time.Since(start).String())
+
+	return connect.NewResponse(&cachev1.HGetAllResponse{
+		Key:    req.Msg.GetKey(),
+		Fields: h,
+		Ttl:    ttl,
+	}), nil
+}
+
+// HIncrBy adds the delta (or 1 if not provided) to the integer stored in a field of the hash at the key.
+func (s *server) HIncrBy(ctx context.Context, req *connect.Request[cachev1.HIncrByRequest]) (*connect.Response[cachev1.HIncrByResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "hash", req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	delta := int64(1)
+	if req.Msg.Delta != nil {
+		delta = req.Msg.GetDelta()
+	}
+
+	// did the user provide a value for the ttl?
+	var ttl int64
+	if req.Msg.GetTtl() > 0 {
+		ttl = time.Now().Add(time.Duration(req.Msg.GetTtl()) * time.Second).Unix()
+	}
+
+	start := time.Now()
+	value, ttl, err := storage.HashIncrement(ctx, s.Store, internalKey, req.Msg.GetField(), delta, ttl)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to increment hash field", "error", err.Error())
+
+		if errors.Is(err, storage.ErrNotInteger) || errors.Is(err, storage.ErrOverflow) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to increment hash field: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "hincrby", "key", internalKey, "field", req.Msg.GetField(), "delta", delta, "duration", time.Since(start).String())
+
+	return connect.NewResponse(&cachev1.HIncrByResponse{
+		Key:   req.Msg.GetKey(),
+		Field: req.Msg.GetField(),
+		Value: value,
+		Ttl:   ttl,
+	}), nil
+}
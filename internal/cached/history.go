@@ -0,0 +1,119 @@
+package cached
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/keygen"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+// History returns the previous revisions of the key kept by the store.
+func (s *server) History(ctx context.Context, req *connect.Request[cachev1.HistoryRequest]) (*connect.Response[cachev1.HistoryResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.FromToken(*t, req.Msg.GetDatabase(), req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	start := time.Now()
+	events, err := s.Store.History(ctx, internalKey)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to get history", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get history: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "history", "key", internalKey, "count", len(events), "duration", time.Since(start).String())
+
+	resp := &cachev1.HistoryResponse{
+		Key:     req.Msg.GetKey(),
+		Entries: make([]*cachev1.HistoryEntry, len(events)),
+	}
+
+	for i, e := range events {
+		resp.Entries[i] = &cachev1.HistoryEntry{
+			Revision:  e.Revision,
+			Operation: watchOperation(e.Operation),
+			Value:     e.Value,
+			Ttl:       e.TTL,
+			Created:   e.Created.Unix(),
+		}
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// Revert stores the value of a previous revision as the latest value of the key. The ttl of the revision
+// is kept unless the request provides a new ttl, if the ttl of the revision has passed a new ttl is
+// required. A revision linked to an object that was removed can not be reverted to.
+func (s *server) Revert(ctx context.Context, req *connect.Request[cachev1.RevertRequest]) (*connect.Response[cachev1.RevertResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.FromToken(*t, req.Msg.GetDatabase(), req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	start := time.Now()
+	e, err := s.Store.GetRevision(ctx, internalKey, req.Msg.GetRevision())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to get revision", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get revision: %w", err))
+	}
+
+	if e == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("revision %d of the key was not found", req.Msg.GetRevision()))
+	}
+
+	i := e.Item
+	if req.Msg.GetTtl() > 0 {
+		i.TTL = time.Now().Add(time.Duration(req.Msg.GetTtl()) * time.Second).Unix()
+	}
+
+	if i.IsExpired() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("revision %d has expired, a ttl is required", req.Msg.GetRevision()))
+	}
+
+	// the object of the revision is removed once the key no longer links to it
+	if i.Object != "" {
+		r, err := s.Objects.Get(ctx, i.Object)
+		if errors.Is(err, storage.ErrKeyNotFound) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the value of revision %d was removed", req.Msg.GetRevision()))
+		}
+		if err != nil {
+			s.Logger.ErrorContext(ctx, "failed to get object", "error", err.Error())
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get object: %w", err))
+		}
+		r.Close()
+	}
+
+	revision, err := s.write(ctx, *t, req.Msg.GetDatabase(), internalKey, i, cachev1.SetCondition_SET_CONDITION_UNSPECIFIED, 0)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to revert key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to revert key: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "revert", "key", internalKey, "revision", req.Msg.GetRevision(), "duration", time.Since(start).String())
+
+	return connect.NewResponse(&cachev1.RevertResponse{
+		Key:      req.Msg.GetKey(),
+		Value:    i.Value,
+		Ttl:      i.TTL,
+		Revision: revision,
+	}), nil
+}
@@ -0,0 +1,56 @@
+package cached
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+func TestServer_Revert_objects(t *testing.T) {
+	s := newNATSServer(t)
+
+	if _, err := s.Objects.Put(context.TODO(), "alice.0-a/1", strings.NewReader("large")); err != nil {
+		t.Fatal(err)
+	}
+
+	object, err := s.Store.Set(context.TODO(), "alice.0-a", storage.Item{Object: "alice.0-a/1", ObjectSize: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	small, err := s.Set(context.TODO(), request(&cachev1.SetRequest{Key: "a", Value: []byte("small")}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the object was removed when the key was overwritten
+	if _, err := s.Revert(context.TODO(), request(&cachev1.RevertRequest{Key: "a", Revision: object})); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("Revert() to a removed object error = %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+
+	if _, err := s.Objects.Put(context.TODO(), "alice.0-a/2", strings.NewReader("large")); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Store.Set(context.TODO(), "alice.0-a", storage.Item{Object: "alice.0-a/2", ObjectSize: 5}); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := s.Revert(context.TODO(), request(&cachev1.RevertRequest{Key: "a", Revision: small.Msg.GetRevision()}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(resp.Msg.GetValue()) != "small" {
+		t.Errorf("Revert() = %v, want the value of the revision", resp.Msg)
+	}
+
+	// the key no longer links to the object so it is removed
+	if _, err := s.Objects.Get(context.TODO(), "alice.0-a/2"); err == nil {
+		t.Error("object of the value that was reverted was not removed")
+	}
+}
@@ -0,0 +1,110 @@
+package cached
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/keygen"
+)
+
+const (
+	// defaultPageSize is the number of keys returned by ListKeys if the request does not provide a page size
+	defaultPageSize = 100
+	// maxPageSize is the maximum number of keys returned by ListKeys
+	maxPageSize = 1000
+)
+
+// ListKeys returns a page of the keys in the database for the subject of the token. The cursor is the
+// last key of the previous page since keys are always returned in sorted order.
+func (s *server) ListKeys(ctx context.Context, req *connect.Request[cachev1.ListKeysRequest]) (*connect.Response[cachev1.ListKeysResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	// the prefix for the database is removed from the keys sent to the client
+	dbPrefix, _, err := keygen.FromToken(*t, req.Msg.GetDatabase(), "")
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	internalPrefix, _, err := keygen.FromToken(*t, req.Msg.GetDatabase(), req.Msg.GetPrefix())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	var after string
+	if req.Msg.GetCursor() != "" {
+		b, err := base64.RawURLEncoding.DecodeString(req.Msg.GetCursor())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid cursor: %w", err))
+		}
+
+		after = dbPrefix + string(b)
+	}
+
+	size := defaultPageSize
+	if req.Msg.GetPageSize() > 0 {
+		size = min(int(req.Msg.GetPageSize()), maxPageSize)
+	}
+
+	start := time.Now()
+	keys, err := s.Store.Keys(ctx, internalPrefix)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to list keys", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list keys: %w", err))
+	}
+
+	// skip the keys up to and including the cursor
+	i := 0
+	if after != "" {
+		i = sort.Search(len(keys), func(i int) bool { return keys[i] > after })
+	}
+
+	resp := &cachev1.ListKeysResponse{}
+	for ; i < len(keys); i++ {
+		key := strings.TrimPrefix(keys[i], dbPrefix)
+		if req.Msg.Match != nil && !keygen.Match(req.Msg.GetMatch(), key) {
+			continue
+		}
+
+		e, err := s.Store.Get(ctx, keys[i])
+		if err != nil {
+			s.Logger.ErrorContext(ctx, "failed to get key", "error", err.Error())
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get key: %w", err))
+		}
+
+		// the key expired
+		if e == nil {
+			continue
+		}
+
+		info := &cachev1.KeyInfo{Key: key}
+		if req.Msg.GetIncludeTtl() {
+			info.Ttl = e.TTL
+		}
+
+		resp.Keys = append(resp.Keys, info)
+
+		if len(resp.Keys) == size {
+			if i < len(keys)-1 {
+				resp.NextCursor = base64.RawURLEncoding.EncodeToString([]byte(key))
+			}
+
+			break
+		}
+	}
+
+	s.Logger.DebugContext(ctx, "list keys", "prefix", internalPrefix, "count", len(resp.Keys), "duration", time.Since(start).String())
+
+	return connect.NewResponse(resp), nil
+}
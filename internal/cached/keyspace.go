@@ -0,0 +1,182 @@
+package cached
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/jasonmccallister/nats-cache/internal/auth"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/keygen"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+// Keyspace reads and writes keys the same way as the cache service so the protocol listeners, such as the
+// Redis and memcached listeners, keep the index of tags, sliding expirations and objects in step with the
+// values they write. Errors from the store, such as storage.ErrKeyExists, are returned as is.
+type Keyspace struct {
+	Store   storage.Store
+	Objects storage.ObjectStore
+	Logger  *slog.Logger
+
+	// sliding has the keys with a sliding expiration that is being refreshed
+	sliding sync.Map
+}
+
+// NewKeyspace returns a new keyspace using the store and the object store of the cache service.
+func NewKeyspace(l *slog.Logger, s storage.Store, o storage.ObjectStore) *Keyspace {
+	return &Keyspace{
+		Store:   s,
+		Objects: o,
+		Logger:  l,
+	}
+}
+
+// Get returns the entry for the key, nil if the key does not exist or has expired. Reading the key
+// refreshes a sliding expiration in the background and the entry has the new ttl.
+func (k *Keyspace) Get(ctx context.Context, t auth.Token, db uint32, key string) (*storage.Entry, error) {
+	internalKey, _, err := keygen.FromToken(t, db, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.read(ctx, internalKey)
+}
+
+// Set stores the item using the condition and returns the new revision, the revision is only used with
+// SET_CONDITION_IF_REVISION. The key is added to the index of the tags of the item and the object of
+// the previous value is removed.
+func (k *Keyspace) Set(ctx context.Context, t auth.Token, db uint32, key string, i storage.Item, condition cachev1.SetCondition, revision uint64) (uint64, error) {
+	internalKey, _, err := keygen.FromToken(t, db, key)
+	if err != nil {
+		return 0, err
+	}
+
+	return k.write(ctx, t, db, internalKey, i, condition, revision)
+}
+
+// Delete removes the key and returns the entry that was removed, nil if the key did not exist. If the
+// revision is not 0 the key is only removed if it was not modified after the revision, otherwise
+// storage.ErrRevisionMismatch is returned.
+func (k *Keyspace) Delete(ctx context.Context, t auth.Token, db uint32, key string, revision uint64) (*storage.Entry, error) {
+	internalKey, _, err := keygen.FromToken(t, db, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.remove(ctx, t, db, internalKey, revision)
+}
+
+// Purge removes every key in the database starting with the prefix along with their objects.
+func (k *Keyspace) Purge(ctx context.Context, t auth.Token, db uint32, prefix string) error {
+	internalKey, _, err := keygen.FromToken(t, db, prefix)
+	if err != nil {
+		return err
+	}
+
+	return k.purge(ctx, internalKey)
+}
+
+// read returns the latest entry for the key and refreshes a sliding expiration.
+func (k *Keyspace) read(ctx context.Context, internalKey string) (*storage.Entry, error) {
+	e, err := k.Store.Get(ctx, internalKey)
+	if err != nil || e == nil {
+		return nil, err
+	}
+
+	k.slide(ctx, internalKey, e)
+
+	return e, nil
+}
+
+// write stores the item using the condition and updates the index of tags and objects of the key. If an
+// error is returned the item was not written.
+func (k *Keyspace) write(ctx context.Context, t auth.Token, db uint32, internalKey string, i storage.Item, condition cachev1.SetCondition, revision uint64) (uint64, error) {
+	// the previous entry is needed to remove the key from the index of tags it no longer has
+	prev, err := k.Store.Get(ctx, internalKey)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.index(ctx, t, db, internalKey, i.Tags); err != nil {
+		return 0, fmt.Errorf("failed to index tags: %w", err)
+	}
+
+	switch condition {
+	case cachev1.SetCondition_SET_CONDITION_IF_NOT_EXISTS:
+		revision, err = k.Store.Create(ctx, internalKey, i)
+	case cachev1.SetCondition_SET_CONDITION_IF_EXISTS:
+		revision, err = storage.Replace(ctx, k.Store, internalKey, i)
+	case cachev1.SetCondition_SET_CONDITION_IF_REVISION:
+		if revision == 0 {
+			revision, err = k.Store.Create(ctx, internalKey, i)
+		} else {
+			revision, err = k.Store.Update(ctx, internalKey, i, revision)
+		}
+	default:
+		revision, err = k.Store.Set(ctx, internalKey, i)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	k.reindex(ctx, t, db, internalKey, i, prev)
+
+	// a revert can link the key to the object it already links to
+	if prev != nil && prev.Object != i.Object {
+		k.removeObject(ctx, prev)
+	}
+
+	return revision, nil
+}
+
+// remove deletes the key, at the revision if it is not 0, and removes the key from the index of its tags
+// along with its object.
+func (k *Keyspace) remove(ctx context.Context, t auth.Token, db uint32, internalKey string, revision uint64) (*storage.Entry, error) {
+	// the entry is needed to remove the key from the index of its tags
+	e, err := k.Store.Get(ctx, internalKey)
+	if err != nil || e == nil {
+		return nil, err
+	}
+
+	if revision == 0 {
+		err = k.Store.Delete(ctx, internalKey)
+	} else {
+		// the key is expired at the revision so it is not removed if it was modified after it was read
+		_, err = k.Store.Update(ctx, internalKey, storage.Item{TTL: time.Now().Add(-time.Second).Unix()}, revision)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range e.Tags {
+		k.unindex(ctx, t, db, internalKey, tag)
+	}
+
+	k.removeObject(ctx, e)
+
+	return e, nil
+}
+
+// purge removes the keys starting with the prefix, objects are named after their key so they are removed
+// using the same prefix.
+func (k *Keyspace) purge(ctx context.Context, prefix string) error {
+	if err := k.Store.Purge(ctx, prefix); err != nil {
+		return fmt.Errorf("failed to purge keys: %w", err)
+	}
+
+	objects, err := k.Objects.List(ctx, prefix)
+	if err != nil {
+		return fmt.Errorf("failed to list objects: %w", err)
+	}
+
+	for _, o := range objects {
+		if err := k.Objects.Delete(ctx, o.Name); err != nil {
+			return fmt.Errorf("failed to purge object: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package cached
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/keygen"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+const (
+	// defaultFillLease is the number of seconds a fill lease is held for if the request does not provide a lease_ttl
+	defaultFillLease = 10
+	// maxFillLease is the maximum number of seconds a fill lease is held for, a longer lease would block
+	// every other caller if the holder fails
+	maxFillLease = 5 * 60
+)
+
+// GetOrLease returns the value of the key. If the key does not exist the caller tries to create the fill
+// lease next to the key, only one caller across every replica can create it and is expected to load the
+// value and call Fill. Every other caller waits for the key to be filled, or for the lease to expire so it
+// can take over the lease. A stale value is returned to every caller without waiting and the caller that
+// creates the lease refreshes it.
+func (s *server) GetOrLease(ctx context.Context, req *connect.Request[cachev1.GetOrLeaseRequest]) (*connect.Response[cachev1.GetOrLeaseResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.FromToken(*t, req.Msg.GetDatabase(), req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	leaseKey, _, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "fill", req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	leaseID, err := newLeaseID()
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create lease id", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create lease id: %w", err))
+	}
+
+	leaseTTL := req.Msg.GetLeaseTtl()
+	if leaseTTL == 0 {
+		leaseTTL = defaultFillLease
+	}
+
+	if leaseTTL > maxFillLease {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("lease_ttl is limited to %d seconds", maxFillLease))
+	}
+
+	resp := &cachev1.GetOrLeaseResponse{
+		Key: req.Msg.GetKey(),
+	}
+
+	// the watch is only started once the caller has to wait so a hit does not pay for it
+	var (
+		events   <-chan storage.Event
+		watching bool
+	)
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	start := time.Now()
+	deadline := start.Add(time.Duration(req.Msg.GetWait()) * time.Millisecond)
+	for {
+		e, err := s.get(ctx, internalKey, &cachev1.GetRequest{})
+		if err != nil {
+			s.Logger.ErrorContext(ctx, "failed to get key", "error", err.Error())
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get key: %w", err))
+		}
+
+		if e != nil && e.Object != "" {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errObject)
+		}
+
+		if e != nil && !e.IsStale() {
+			s.Logger.DebugContext(ctx, "get or lease hit", "key", internalKey, "duration", time.Since(start).String())
+
+			resp.Value = e.Value
+			resp.Ttl = e.TTL
+			resp.Revision = e.Revision
+			resp.Found = true
+			resp.Freshness = cachev1.Freshness_FRESHNESS_FRESH
+			resp.SoftTtl = e.SoftTTL
+
+			return connect.NewResponse(resp), nil
+		}
+
+		ttl := time.Now().Add(time.Duration(leaseTTL) * time.Second).Unix()
+
+		_, err = s.Store.Create(ctx, leaseKey, storage.Item{
+			Value: []byte(leaseID),
+			TTL:   ttl,
+		})
+		if err != nil && !errors.Is(err, storage.ErrKeyExists) {
+			s.Logger.ErrorContext(ctx, "failed to create fill lease", "error", err.Error())
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create fill lease: %w", err))
+		}
+
+		if err == nil {
+			s.Logger.DebugContext(ctx, "granted fill lease", "key", internalKey, "duration", time.Since(start).String())
+
+			resp.LeaseId = leaseID
+			resp.LeaseTtl = ttl
+		}
+
+		// a stale value is returned without waiting, only the lease holder refreshes it
+		if e != nil {
+			s.Logger.DebugContext(ctx, "get or lease stale", "key", internalKey, "duration", time.Since(start).String())
+
+			resp.Value = e.Value
+			resp.Ttl = e.TTL
+			resp.Revision = e.Revision
+			resp.Found = true
+			resp.Freshness = cachev1.Freshness_FRESHNESS_STALE
+			resp.SoftTtl = e.SoftTTL
+		}
+
+		if err == nil || e != nil {
+			return connect.NewResponse(resp), nil
+		}
+
+		if time.Now().After(deadline) {
+			break
+		}
+
+		if !watching {
+			watching = true
+			events, err = s.Store.Watch(watchCtx, internalKey, false)
+			if err != nil {
+				s.Logger.ErrorContext(ctx, "failed to watch key", "error", err.Error())
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to watch key: %w", err))
+			}
+
+			// the key may have been filled before the watch started
+			continue
+		}
+
+		// the lease expiring does not send an event so the lease is also checked on an interval
+		select {
+		case <-ctx.Done():
+			return nil, connect.NewError(connect.CodeDeadlineExceeded, ctx.Err())
+		case _, ok := <-events:
+			if !ok {
+				// a closed watch blocks forever and the lease is checked on the interval
+				events = nil
+			}
+		case <-time.After(lockPollInterval):
+		}
+	}
+
+	s.Logger.DebugContext(ctx, "fill lease is held", "key", internalKey, "duration", time.Since(start).String())
+
+	// return when the current holder's lease expires so the client knows when to try again
+	e, err := s.Store.Get(ctx, leaseKey)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to get fill lease", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get fill lease: %w", err))
+	}
+
+	if e != nil {
+		resp.LeaseTtl = e.TTL
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// Fill stores the value of a key using the lease from GetOrLease and releases the lease. The lease is
+// released by revision so a lease taken over by another caller is never released.
+func (s *server) Fill(ctx context.Context, req *connect.Request[cachev1.FillRequest]) (*connect.Response[cachev1.FillResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.FromToken(*t, req.Msg.GetDatabase(), req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	leaseKey, _, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "fill", req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	start := time.Now()
+	lease, err := s.Store.Get(ctx, leaseKey)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to get fill lease", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get fill lease: %w", err))
+	}
+
+	if lease == nil || string(lease.Value) != req.Msg.GetLeaseId() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("lease_id does not hold the fill lease"))
+	}
+
+	revision, ttl, err := s.set(ctx, *t, internalKey, &cachev1.SetRequest{
+		Database: req.Msg.Database,
+		Key:      req.Msg.GetKey(),
+		Value:    req.Msg.GetValue(),
+		Ttl:      req.Msg.Ttl,
+		Tags:     req.Msg.GetTags(),
+		SoftTtl:  req.Msg.SoftTtl,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// waiting callers are woken by the write so the lease only has to be removed for the next miss
+	_, err = s.Store.Update(ctx, leaseKey, storage.Item{TTL: time.Now().Unix() - 1}, lease.Revision)
+	if err != nil && !errors.Is(err, storage.ErrRevisionMismatch) {
+		s.Logger.ErrorContext(ctx, "failed to release fill lease", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to release fill lease: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "fill", "key", internalKey, "duration", time.Since(start).String())
+
+	return connect.NewResponse(&cachev1.FillResponse{
+		Key:      req.Msg.GetKey(),
+		Ttl:      ttl,
+		Revision: revision,
+	}), nil
+}
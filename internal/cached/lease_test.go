@@ -0,0 +1,100 @@
+package cached
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+func TestServer_GetOrLease(t *testing.T) {
+	s := newServer(t)
+
+	first, err := s.GetOrLease(context.TODO(), request(&cachev1.GetOrLeaseRequest{Key: "a", LeaseTtl: ptr[uint32](100)}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if first.Msg.GetFound() || first.Msg.GetLeaseId() == "" || first.Msg.GetLeaseTtl() == 0 {
+		t.Fatalf("GetOrLease() = %v, want a lease for a miss", first.Msg)
+	}
+
+	// the lease is held so the next caller does not get one
+	second, err := s.GetOrLease(context.TODO(), request(&cachev1.GetOrLeaseRequest{Key: "a"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if second.Msg.GetLeaseId() != "" || second.Msg.GetLeaseTtl() != first.Msg.GetLeaseTtl() {
+		t.Errorf("GetOrLease() while held = %v, want no lease and the expiration of the held lease", second.Msg)
+	}
+
+	if _, err := s.Fill(context.TODO(), request(&cachev1.FillRequest{Key: "a", LeaseId: "wrong", Value: []byte("a")})); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("Fill() with the wrong lease error = %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+
+	fill, err := s.Fill(context.TODO(), request(&cachev1.FillRequest{Key: "a", LeaseId: first.Msg.GetLeaseId(), Value: []byte("a")}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hit, err := s.GetOrLease(context.TODO(), request(&cachev1.GetOrLeaseRequest{Key: "a"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !hit.Msg.GetFound() || string(hit.Msg.GetValue()) != "a" || hit.Msg.GetRevision() != fill.Msg.GetRevision() || hit.Msg.GetLeaseId() != "" {
+		t.Errorf("GetOrLease() after Fill() = %v, want the filled value", hit.Msg)
+	}
+
+	// the lease is released by the fill so it can not be used again
+	if _, err := s.Fill(context.TODO(), request(&cachev1.FillRequest{Key: "a", LeaseId: first.Msg.GetLeaseId(), Value: []byte("b")})); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("Fill() with a released lease error = %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+}
+
+func TestServer_GetOrLease_expired(t *testing.T) {
+	s := newServer(t)
+
+	// a lease of another caller that expired
+	if _, err := s.Store.Set(context.TODO(), "alice.0.fill-a", storage.Item{Value: []byte("old"), TTL: time.Now().Unix() - 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := s.GetOrLease(context.TODO(), request(&cachev1.GetOrLeaseRequest{Key: "a"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Msg.GetLeaseId() == "" || resp.Msg.GetLeaseId() == "old" {
+		t.Fatalf("GetOrLease() = %v, want to take over the expired lease", resp.Msg)
+	}
+
+	if _, err := s.Fill(context.TODO(), request(&cachev1.FillRequest{Key: "a", LeaseId: "old", Value: []byte("a")})); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("Fill() with the expired lease error = %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+
+	if _, err := s.Fill(context.TODO(), request(&cachev1.FillRequest{Key: "a", LeaseId: resp.Msg.GetLeaseId(), Value: []byte("a")})); err != nil {
+		t.Errorf("Fill() error = %v", err)
+	}
+}
+
+func TestServer_GetOrLease_leaseTTL(t *testing.T) {
+	s := newServer(t)
+
+	if _, err := s.GetOrLease(context.TODO(), request(&cachev1.GetOrLeaseRequest{Key: "a", LeaseTtl: ptr[uint32](maxFillLease + 1)})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("GetOrLease() with a long lease error = %v, want %v", err, connect.CodeInvalidArgument)
+	}
+
+	resp, err := s.GetOrLease(context.TODO(), request(&cachev1.GetOrLeaseRequest{Key: "a", LeaseTtl: ptr[uint32](maxFillLease)}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Msg.GetLeaseId() == "" || resp.Msg.GetLeaseTtl() > time.Now().Unix()+maxFillLease {
+		t.Errorf("GetOrLease() = %v, want a lease of at most %d seconds", resp.Msg, maxFillLease)
+	}
+}
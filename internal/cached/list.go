@@ -0,0 +1,245 @@
+package cached
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jasonmccallister/nats-cache/internal/auth"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/keygen"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+// LPush adds the values to the front of the list at the key.
+func (s *server) LPush(ctx context.Context, req *connect.Request[cachev1.LPushRequest]) (*connect.Response[cachev1.LPushResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	length, err := s.push(ctx, *t, req.Msg.GetDatabase(), req.Msg.GetKey(), req.Msg.GetValues(), req.Msg.GetTtl(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&cachev1.LPushResponse{
+		Key:    req.Msg.GetKey(),
+		Length: uint64(length),
+	}), nil
+}
+
+// RPush adds the values to the back of the list at the key.
+func (s *server) RPush(ctx context.Context, req *connect.Request[cachev1.RPushRequest]) (*connect.Response[cachev1.RPushResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	length, err := s.push(ctx, *t, req.Msg.GetDatabase(), req.Msg.GetKey(), req.Msg.GetValues(), req.Msg.GetTtl(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&cachev1.RPushResponse{
+		Key:    req.Msg.GetKey(),
+		Length: uint64(length),
+	}), nil
+}
+
+// LPop removes values from the front of the list at the key.
+func (s *server) LPop(ctx context.Context, req *connect.Request[cachev1.LPopRequest]) (*connect.Response[cachev1.LPopResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	values, err := s.pop(ctx, *t, req.Msg.GetDatabase(), req.Msg.GetKey(), req.Msg.Count, req.Msg.GetWait(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&cachev1.LPopResponse{
+		Key:    req.Msg.GetKey(),
+		Values: values,
+	}), nil
+}
+
+// RPop removes values from the back of the list at the key.
+func (s *server) RPop(ctx context.Context, req *connect.Request[cachev1.RPopRequest]) (*connect.Response[cachev1.RPopResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	values, err := s.pop(ctx, *t, req.Msg.GetDatabase(), req.Msg.GetKey(), req.Msg.Count, req.Msg.GetWait(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&cachev1.RPopResponse{
+		Key:    req.Msg.GetKey(),
+		Values: values,
+	}), nil
+}
+
+// LRange returns the values of the list at the key between the start and stop indexes.
+func (s *server) LRange(ctx context.Context, req *connect.Request[cachev1.LRangeRequest]) (*connect.Response[cachev1.LRangeResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "list", req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	start := time.Now()
+	values, err := storage.ListRange(ctx, s.Store, internalKey, req.Msg.GetStart(), req.Msg.GetStop())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to get list", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get list: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "lrange", "key", internalKey, "count", len(values), "duration", time.Since(start).String())
+
+	return connect.NewResponse(&cachev1.LRangeResponse{
+		Key:    req.Msg.GetKey(),
+		Values: values,
+	}), nil
+}
+
+// LLen returns the length of the list at the key.
+func (s *server) LLen(ctx context.Context, req *connect.Request[cachev1.LLenRequest]) (*connect.Response[cachev1.LLenResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "list", req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	start := time.Now()
+	values, _, err := storage.ListGet(ctx, s.Store, internalKey)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to get list", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get list: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "llen", "key", internalKey, "duration", time.Since(start).String())
+
+	return connect.NewResponse(&cachev1.LLenResponse{
+		Key:    req.Msg.GetKey(),
+		Length: uint64(len(values)),
+	}), nil
+}
+
+// LTrim keeps the values of the list at the key between the start and stop indexes.
+func (s *server) LTrim(ctx context.Context, req *connect.Request[cachev1.LTrimRequest]) (*connect.Response[cachev1.LTrimResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "list", req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	start := time.Now()
+	length, err := storage.ListTrim(ctx, s.Store, internalKey, req.Msg.GetStart(), req.Msg.GetStop())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to trim list", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to trim list: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "ltrim", "key", internalKey, "length", length, "duration", time.Since(start).String())
+
+	return connect.NewResponse(&cachev1.LTrimResponse{
+		Key:    req.Msg.GetKey(),
+		Length: uint64(length),
+	}), nil
+}
+
+// push is shared by LPush and RPush and converts storage errors into connect errors.
+func (s *server) push(ctx context.Context, t auth.Token, db uint32, key string, values [][]byte, seconds uint32, left bool) (int, error) {
+	if len(values) == 0 {
+		return 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at least one value is required"))
+	}
+
+	internalKey, _, err := keygen.ForKind(t, db, "list", key)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return 0, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	// did the user provide a value for the ttl?
+	var ttl int64
+	if seconds > 0 {
+		ttl = time.Now().Add(time.Duration(seconds) * time.Second).Unix()
+	}
+
+	start := time.Now()
+	length, err := storage.ListPush(ctx, s.Store, internalKey, values, left, ttl)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to push to list", "error", err.Error())
+		return 0, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to push to list: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "push", "key", internalKey, "left", left, "length", length, "duration", time.Since(start).String())
+
+	return length, nil
+}
+
+// pop is shared by LPop and RPop and converts storage errors into connect errors. If the list is empty
+// it waits up to wait milliseconds for values to be pushed.
+func (s *server) pop(ctx context.Context, t auth.Token, db uint32, key string, count *uint32, wait uint32, left bool) ([][]byte, error) {
+	internalKey, _, err := keygen.ForKind(t, db, "list", key)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	n := 1
+	if count != nil {
+		if *count == 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("count must be greater than 0"))
+		}
+
+		n = int(*count)
+	}
+
+	start := time.Now()
+
+	var values storage.List
+	if wait > 0 {
+		waitCtx, cancel := context.WithTimeout(ctx, time.Duration(wait)*time.Millisecond)
+		defer cancel()
+
+		values, err = storage.ListPopWait(waitCtx, s.Store, internalKey, n, left)
+	} else {
+		values, err = storage.ListPop(ctx, s.Store, internalKey, n, left)
+	}
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to pop from list", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to pop from list: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "pop", "key", internalKey, "left", left, "count", len(values), "duration", time.Since(start).String())
+
+	return values, nil
+}
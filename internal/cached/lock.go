@@ -0,0 +1,234 @@
+package cached
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jasonmccallister/nats-cache/internal/auth"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/gen/cache/v1/cachev1connect"
+	"github.com/jasonmccallister/nats-cache/internal/keygen"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+const (
+	// defaultLease is the number of seconds a lock is held for if the request does not provide a ttl
+	defaultLease = 30
+	// lockPollInterval is how often a held lock is checked while waiting to acquire it
+	lockPollInterval = 50 * time.Millisecond
+)
+
+type lockServer struct {
+	Authorizer auth.Authorizer
+	Store      storage.Store
+	Logger     *slog.Logger
+
+	cachev1connect.UnimplementedLockServiceHandler
+}
+
+// NewLockServer returns a new server for the lock service.
+func NewLockServer(l *slog.Logger, a auth.Authorizer, s storage.Store) cachev1connect.LockServiceHandler {
+	return &lockServer{
+		Logger:     l,
+		Authorizer: a,
+		Store:      s,
+	}
+}
+
+// Acquire creates the lock if it is not held. The lease id is stored as the value of the lock and the
+// revision the lock was created at is returned as the fencing token.
+func (s *lockServer) Acquire(ctx context.Context, req *connect.Request[cachev1.AcquireRequest]) (*connect.Response[cachev1.AcquireResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	if req.Msg.GetName() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+	}
+
+	internalKey, name, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "lock", req.Msg.GetName())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	leaseID, err := newLeaseID()
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create lease id", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create lease id: %w", err))
+	}
+
+	start := time.Now()
+	deadline := start.Add(time.Duration(req.Msg.GetWait()) * time.Millisecond)
+	for {
+		ttl := time.Now().Add(leaseDuration(req.Msg.GetTtl())).Unix()
+
+		revision, err := s.Store.Create(ctx, internalKey, storage.Item{
+			Value: []byte(leaseID),
+			TTL:   ttl,
+		})
+		if err == nil {
+			s.Logger.DebugContext(ctx, "acquired lock", "key", internalKey, "fencing_token", revision, "duration", time.Since(start).String())
+
+			return connect.NewResponse(&cachev1.AcquireResponse{
+				Name:         name,
+				Acquired:     true,
+				LeaseId:      leaseID,
+				FencingToken: revision,
+				Ttl:          ttl,
+			}), nil
+		}
+
+		if !errors.Is(err, storage.ErrKeyExists) {
+			s.Logger.ErrorContext(ctx, "failed to acquire lock", "error", err.Error())
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to acquire lock: %w", err))
+		}
+
+		if time.Now().After(deadline) {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, connect.NewError(connect.CodeDeadlineExceeded, ctx.Err())
+		case <-time.After(lockPollInterval):
+		}
+	}
+
+	s.Logger.DebugContext(ctx, "lock is held", "key", internalKey, "duration", time.Since(start).String())
+
+	// return when the current holder's lease expires so the client knows when to try again
+	resp := &cachev1.AcquireResponse{
+		Name: name,
+	}
+
+	e, err := s.Store.Get(ctx, internalKey)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to get lock", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get lock: %w", err))
+	}
+
+	if e != nil {
+		resp.Ttl = e.TTL
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// Renew extends the lease of a held lock. If the lease expired or the lock is held by another
+// lease the error code is FAILED_PRECONDITION.
+func (s *lockServer) Renew(ctx context.Context, req *connect.Request[cachev1.RenewRequest]) (*connect.Response[cachev1.RenewResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, name, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "lock", req.Msg.GetName())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	start := time.Now()
+	for {
+		e, err := s.Store.Get(ctx, internalKey)
+		if err != nil {
+			s.Logger.ErrorContext(ctx, "failed to get lock", "error", err.Error())
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get lock: %w", err))
+		}
+
+		if e == nil || string(e.Value) != req.Msg.GetLeaseId() {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("lock is not held by the lease"))
+		}
+
+		ttl := time.Now().Add(leaseDuration(req.Msg.GetTtl())).Unix()
+
+		_, err = s.Store.Update(ctx, internalKey, storage.Item{Value: e.Value, TTL: ttl}, e.Revision)
+		if errors.Is(err, storage.ErrRevisionMismatch) {
+			continue
+		}
+
+		if err != nil {
+			s.Logger.ErrorContext(ctx, "failed to renew lock", "error", err.Error())
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to renew lock: %w", err))
+		}
+
+		s.Logger.DebugContext(ctx, "renewed lock", "key", internalKey, "duration", time.Since(start).String())
+
+		return connect.NewResponse(&cachev1.RenewResponse{
+			Name: name,
+			Ttl:  ttl,
+		}), nil
+	}
+}
+
+// Release releases a held lock by expiring it, the expired lock is checked by revision so a lock
+// acquired by another client in the meantime is never released.
+func (s *lockServer) Release(ctx context.Context, req *connect.Request[cachev1.ReleaseRequest]) (*connect.Response[cachev1.ReleaseResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "lock", req.Msg.GetName())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	start := time.Now()
+	for {
+		e, err := s.Store.Get(ctx, internalKey)
+		if err != nil {
+			s.Logger.ErrorContext(ctx, "failed to get lock", "error", err.Error())
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get lock: %w", err))
+		}
+
+		if e == nil || string(e.Value) != req.Msg.GetLeaseId() {
+			return connect.NewResponse(&cachev1.ReleaseResponse{Released: false}), nil
+		}
+
+		_, err = s.Store.Update(ctx, internalKey, storage.Item{TTL: time.Now().Unix() - 1}, e.Revision)
+		if errors.Is(err, storage.ErrRevisionMismatch) {
+			continue
+		}
+
+		if err != nil {
+			s.Logger.ErrorContext(ctx, "failed to release lock", "error", err.Error())
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to release lock: %w", err))
+		}
+
+		s.Logger.DebugContext(ctx, "released lock", "key", internalKey, "duration", time.Since(start).String())
+
+		return connect.NewResponse(&cachev1.ReleaseResponse{Released: true}), nil
+	}
+}
+
+// leaseDuration returns the duration of a lease using the default if seconds is 0.
+func leaseDuration(seconds uint32) time.Duration {
+	if seconds == 0 {
+		seconds = defaultLease
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+// newLeaseID returns a random id used to identify the holder of a lock.
+func newLeaseID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,87 @@
+package cached
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"connectrpc.com/connect"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+func TestLockServer(t *testing.T) {
+	s := NewLockServer(slog.New(slog.NewTextHandler(io.Discard, nil)), subjectAuthorizer{}, storage.NewInMemory())
+
+	first, err := s.Acquire(context.TODO(), request(&cachev1.AcquireRequest{Name: "job", Ttl: ptr[uint32](10)}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !first.Msg.GetAcquired() || first.Msg.GetLeaseId() == "" || first.Msg.GetFencingToken() == 0 {
+		t.Fatalf("Acquire() = %v, want the lock", first.Msg)
+	}
+
+	held, err := s.Acquire(context.TODO(), request(&cachev1.AcquireRequest{Name: "job"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if held.Msg.GetAcquired() || held.Msg.GetLeaseId() != "" || held.Msg.GetTtl() != first.Msg.GetTtl() {
+		t.Errorf("Acquire() while held = %v, want the expiration of the held lease", held.Msg)
+	}
+
+	if _, err := s.Renew(context.TODO(), request(&cachev1.RenewRequest{Name: "job", LeaseId: "wrong"})); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("Renew() with the wrong lease error = %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+
+	renew, err := s.Renew(context.TODO(), request(&cachev1.RenewRequest{Name: "job", LeaseId: first.Msg.GetLeaseId(), Ttl: ptr[uint32](100)}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if renew.Msg.GetTtl() <= first.Msg.GetTtl() {
+		t.Errorf("Renew() ttl = %d, want later than %d", renew.Msg.GetTtl(), first.Msg.GetTtl())
+	}
+
+	release, err := s.Release(context.TODO(), request(&cachev1.ReleaseRequest{Name: "job", LeaseId: "wrong"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if release.Msg.GetReleased() {
+		t.Error("Release() with the wrong lease released the lock")
+	}
+
+	release, err = s.Release(context.TODO(), request(&cachev1.ReleaseRequest{Name: "job", LeaseId: first.Msg.GetLeaseId()}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !release.Msg.GetReleased() {
+		t.Error("Release() did not release the lock")
+	}
+
+	second, err := s.Acquire(context.TODO(), request(&cachev1.AcquireRequest{Name: "job"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !second.Msg.GetAcquired() {
+		t.Fatalf("Acquire() after Release() = %v, want the lock", second.Msg)
+	}
+
+	// the fencing token rejects writes from the previous holder
+	if second.Msg.GetFencingToken() <= first.Msg.GetFencingToken() {
+		t.Errorf("Acquire() fencing token = %d, want greater than %d", second.Msg.GetFencingToken(), first.Msg.GetFencingToken())
+	}
+
+	if _, err := s.Renew(context.TODO(), request(&cachev1.RenewRequest{Name: "job", LeaseId: first.Msg.GetLeaseId()})); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("Renew() with a released lease error = %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+
+	if _, err := s.Acquire(context.TODO(), request(&cachev1.AcquireRequest{})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("Acquire() without a name error = %v, want %v", err, connect.CodeInvalidArgument)
+	}
+}
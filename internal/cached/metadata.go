@@ -0,0 +1,56 @@
+package cached
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/keygen"
+)
+
+// GetMetadata returns the metadata, size and expiration of the key without returning the value. It does
+// not count as a read so a sliding expiration is not refreshed.
+func (s *server) GetMetadata(ctx context.Context, req *connect.Request[cachev1.GetMetadataRequest]) (*connect.Response[cachev1.GetMetadataResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.FromToken(*t, req.Msg.GetDatabase(), req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	start := time.Now()
+	e, err := s.Store.Get(ctx, internalKey)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to get key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get key: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "get metadata", "key", internalKey, "duration", time.Since(start).String())
+
+	resp := &cachev1.GetMetadataResponse{
+		Key: req.Msg.GetKey(),
+	}
+
+	if e != nil {
+		resp.Exists = true
+		resp.ContentType = e.ContentType
+		resp.ContentEncoding = e.ContentEncoding
+		resp.Metadata = e.Metadata
+		resp.Size = uint64(len(e.Value))
+		if e.Object != "" {
+			resp.Size = e.ObjectSize
+		}
+		resp.Ttl = e.TTL
+		resp.SoftTtl = e.SoftTTL
+		resp.Revision = e.Revision
+	}
+
+	return connect.NewResponse(resp), nil
+}
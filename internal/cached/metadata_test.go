@@ -0,0 +1,80 @@
+package cached
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+)
+
+func TestServer_metadata(t *testing.T) {
+	s := newServer(t)
+
+	metadata := map[string]string{"etag": "abc", "source": "db"}
+
+	if _, err := s.Set(context.TODO(), request(&cachev1.SetRequest{
+		Key:             "a",
+		Value:           []byte(`{"a":1}`),
+		ContentType:     "application/json",
+		ContentEncoding: "gzip",
+		Metadata:        metadata,
+	})); err != nil {
+		t.Fatal(err)
+	}
+
+	get, err := s.Get(context.TODO(), request(&cachev1.GetRequest{Key: "a"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if get.Msg.GetContentType() != "application/json" || get.Msg.GetContentEncoding() != "gzip" || !maps.Equal(get.Msg.GetMetadata(), metadata) {
+		t.Errorf("Get() = %v, want the content type and metadata that were set", get.Msg)
+	}
+
+	multi, err := s.GetMulti(context.TODO(), request(&cachev1.GetMultiRequest{Keys: []string{"a"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item := multi.Msg.GetItems()[0]
+	if item.GetContentType() != "application/json" || item.GetContentEncoding() != "gzip" || !maps.Equal(item.GetMetadata(), metadata) {
+		t.Errorf("GetMulti() = %v, want the content type and metadata that were set", item)
+	}
+
+	meta, err := s.GetMetadata(context.TODO(), request(&cachev1.GetMetadataRequest{Key: "a"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !meta.Msg.GetExists() || meta.Msg.GetSize() != 7 || meta.Msg.GetContentType() != "application/json" || !maps.Equal(meta.Msg.GetMetadata(), metadata) {
+		t.Errorf("GetMetadata() = %v, want the description of the value", meta.Msg)
+	}
+
+	if meta.Msg.GetRevision() != get.Msg.GetRevision() {
+		t.Errorf("GetMetadata() revision = %d, want %d", meta.Msg.GetRevision(), get.Msg.GetRevision())
+	}
+
+	// a write without metadata replaces the metadata
+	if _, err := s.Set(context.TODO(), request(&cachev1.SetRequest{Key: "a", Value: []byte("b")})); err != nil {
+		t.Fatal(err)
+	}
+
+	get, err = s.Get(context.TODO(), request(&cachev1.GetRequest{Key: "a"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if get.Msg.GetContentType() != "" || len(get.Msg.GetMetadata()) != 0 {
+		t.Errorf("Get() after overwrite = %v, want no content type or metadata", get.Msg)
+	}
+
+	missing, err := s.GetMetadata(context.TODO(), request(&cachev1.GetMetadataRequest{Key: "missing"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if missing.Msg.GetExists() {
+		t.Errorf("GetMetadata() for a missing key = %v, want exists false", missing.Msg)
+	}
+}
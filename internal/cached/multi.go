@@ -0,0 +1,130 @@
+package cached
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/keygen"
+)
+
+const (
+	// maxBatchSize is the maximum number of items in a SetMulti or DeleteMulti request
+	maxBatchSize = 1000
+	// batchWorkers is the number of items in a batch that are written to the store at the same time
+	batchWorkers = 16
+)
+
+// SetMulti writes every item in the request and returns the result of each item, an item that fails
+// is reported in its result instead of failing the request.
+func (s *server) SetMulti(ctx context.Context, req *connect.Request[cachev1.SetMultiRequest]) (*connect.Response[cachev1.SetMultiResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	items := req.Msg.GetItems()
+	if len(items) > maxBatchSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("batch is limited to %d items", maxBatchSize))
+	}
+
+	results := make([]*cachev1.SetMultiResult, len(items))
+
+	start := time.Now()
+	batch(len(items), func(i int) {
+		item := items[i]
+		results[i] = &cachev1.SetMultiResult{Key: item.GetKey()}
+
+		internalKey, _, err := keygen.FromToken(*t, req.Msg.GetDatabase(), item.GetKey())
+		if err != nil {
+			s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+			results[i].Error = fmt.Sprintf("failed to create key: %s", err.Error())
+			return
+		}
+
+		revision, ttl, err := s.set(ctx, *t, internalKey, &cachev1.SetRequest{
+			Database: req.Msg.Database,
+			Key:      item.GetKey(),
+			Value:    item.GetValue(),
+			Ttl:      item.Ttl,
+		})
+		if err != nil {
+			results[i].Error = err.Error()
+			return
+		}
+
+		results[i].Success = true
+		results[i].Ttl = ttl
+		results[i].Revision = revision
+	})
+
+	s.Logger.DebugContext(ctx, "set multiple", "count", len(items), "duration", time.Since(start).String())
+
+	return connect.NewResponse(&cachev1.SetMultiResponse{Results: results}), nil
+}
+
+// DeleteMulti deletes every key in the request and returns the result of each key, a key that fails
+// is reported in its result instead of failing the request.
+func (s *server) DeleteMulti(ctx context.Context, req *connect.Request[cachev1.DeleteMultiRequest]) (*connect.Response[cachev1.DeleteMultiResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	keys := req.Msg.GetKeys()
+	if len(keys) > maxBatchSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("batch is limited to %d keys", maxBatchSize))
+	}
+
+	results := make([]*cachev1.DeleteMultiResult, len(keys))
+
+	start := time.Now()
+	batch(len(keys), func(i int) {
+		results[i] = &cachev1.DeleteMultiResult{Key: keys[i]}
+
+		internalKey, _, err := keygen.FromToken(*t, req.Msg.GetDatabase(), keys[i])
+		if err != nil {
+			s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+			results[i].Error = fmt.Sprintf("failed to create key: %s", err.Error())
+			return
+		}
+
+		if _, err := s.remove(ctx, *t, req.Msg.GetDatabase(), internalKey, 0); err != nil {
+			s.Logger.ErrorContext(ctx, "failed to delete key", "error", err.Error())
+			results[i].Error = fmt.Sprintf("failed to delete key: %s", err.Error())
+			return
+		}
+
+		results[i].Deleted = true
+	})
+
+	s.Logger.DebugContext(ctx, "delete multiple", "count", len(keys), "duration", time.Since(start).String())
+
+	return connect.NewResponse(&cachev1.DeleteMultiResponse{Results: results}), nil
+}
+
+// batch calls fn for every index up to n using at most batchWorkers goroutines and waits for them
+// to finish.
+func batch(n int, fn func(i int)) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, batchWorkers)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			fn(i)
+		}(i)
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,159 @@
+package cached
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+// failingStore fails writes and deletes of keys ending with "fail".
+type failingStore struct {
+	storage.Store
+}
+
+func (f failingStore) Set(ctx context.Context, key string, i storage.Item) (uint64, error) {
+	if strings.HasSuffix(key, "fail") {
+		return 0, fmt.Errorf("unavailable")
+	}
+
+	return f.Store.Set(ctx, key, i)
+}
+
+func (f failingStore) Delete(ctx context.Context, key string) error {
+	if strings.HasSuffix(key, "fail") {
+		return fmt.Errorf("unavailable")
+	}
+
+	return f.Store.Delete(ctx, key)
+}
+
+func TestServer_SetMulti(t *testing.T) {
+	s := newServer(t)
+	s.Store = failingStore{Store: s.Store}
+
+	resp, err := s.SetMulti(context.TODO(), request(&cachev1.SetMultiRequest{Items: []*cachev1.SetMultiItem{
+		{Key: "a", Value: []byte("a")},
+		{Key: "fail", Value: []byte("fail")},
+		{Key: "b", Value: []byte("b"), Ttl: ptr[uint32](100)},
+	}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := resp.Msg.GetResults()
+	if len(results) != 3 {
+		t.Fatalf("SetMulti() results = %d, want 3", len(results))
+	}
+
+	for i, want := range []struct {
+		key     string
+		success bool
+	}{{"a", true}, {"fail", false}, {"b", true}} {
+		r := results[i]
+		if r.GetKey() != want.key || r.GetSuccess() != want.success {
+			t.Errorf("SetMulti() result %d = %s %v, want %s %v", i, r.GetKey(), r.GetSuccess(), want.key, want.success)
+		}
+
+		if want.success && (r.GetError() != "" || r.GetRevision() == 0) {
+			t.Errorf("SetMulti() result %d = error %q revision %d, want a revision", i, r.GetError(), r.GetRevision())
+		}
+
+		if !want.success && r.GetError() == "" {
+			t.Errorf("SetMulti() result %d has no error", i)
+		}
+	}
+
+	if results[2].GetTtl() == 0 {
+		t.Error("SetMulti() ttl of b = 0, want the expiration")
+	}
+
+	get, err := s.Get(context.TODO(), request(&cachev1.GetRequest{Key: "b"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(get.Msg.GetValue()) != "b" {
+		t.Errorf("Get(b) = %q, want b", get.Msg.GetValue())
+	}
+}
+
+func TestServer_DeleteMulti(t *testing.T) {
+	s := newServer(t)
+
+	for _, key := range []string{"a", "fail"} {
+		if _, err := s.Set(context.TODO(), request(&cachev1.SetRequest{Key: key, Value: []byte(key)})); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s.Store = failingStore{Store: s.Store}
+
+	resp, err := s.DeleteMulti(context.TODO(), request(&cachev1.DeleteMultiRequest{Keys: []string{"a", "fail", "missing"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := resp.Msg.GetResults()
+	if len(results) != 3 {
+		t.Fatalf("DeleteMulti() results = %d, want 3", len(results))
+	}
+
+	if !results[0].GetDeleted() || results[0].GetError() != "" {
+		t.Errorf("DeleteMulti() a = %v, want deleted", results[0])
+	}
+
+	if results[1].GetDeleted() || results[1].GetError() == "" {
+		t.Errorf("DeleteMulti() fail = %v, want an error", results[1])
+	}
+
+	if results[2].GetKey() != "missing" || results[2].GetError() != "" {
+		t.Errorf("DeleteMulti() missing = %v, want no error", results[2])
+	}
+
+	get, err := s.Get(context.TODO(), request(&cachev1.GetRequest{Key: "fail"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(get.Msg.GetValue()) != "fail" {
+		t.Errorf("Get(fail) = %q, want the key that failed to be kept", get.Msg.GetValue())
+	}
+}
+
+func TestServer_multi_limits(t *testing.T) {
+	s := newServer(t)
+
+	items := make([]*cachev1.SetMultiItem, maxBatchSize+1)
+	keys := make([]string, maxBatchSize+1)
+	for i := range items {
+		items[i] = &cachev1.SetMultiItem{Key: fmt.Sprint(i)}
+		keys[i] = fmt.Sprint(i)
+	}
+
+	if _, err := s.SetMulti(context.TODO(), request(&cachev1.SetMultiRequest{Items: items})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("SetMulti() error = %v, want %v", err, connect.CodeInvalidArgument)
+	}
+
+	if _, err := s.DeleteMulti(context.TODO(), request(&cachev1.DeleteMultiRequest{Keys: keys})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("DeleteMulti() error = %v, want %v", err, connect.CodeInvalidArgument)
+	}
+
+	// a full batch is accepted
+	resp, err := s.SetMulti(context.TODO(), request(&cachev1.SetMultiRequest{Items: items[:maxBatchSize]}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resp.Msg.GetResults()) != maxBatchSize {
+		t.Errorf("SetMulti() results = %d, want %d", len(resp.Msg.GetResults()), maxBatchSize)
+	}
+
+	if _, err := s.SetMulti(context.TODO(), connect.NewRequest(&cachev1.SetMultiRequest{})); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("SetMulti() without a token error = %v, want %v", err, connect.CodeUnauthenticated)
+	}
+}
@@ -0,0 +1,224 @@
+package cached
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"connectrpc.com/connect"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/keygen"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+// objectChunkSize is the size of the chunks of data sent by GetObject
+const objectChunkSize = 128 * 1024
+
+// errObject is returned when a value stored with PutObject is read by a method that returns the value
+// in the response.
+var errObject = errors.New("value is stored as an object, use GetObject")
+
+// PutObject streams the value into a new object and writes the key with a link to the object. The object
+// has a unique name so readers of the previous value are not affected, the previous object is removed once
+// the key has been written.
+func (s *server) PutObject(ctx context.Context, stream *connect.ClientStream[cachev1.PutObjectRequest]) (*connect.Response[cachev1.PutObjectResponse], error) {
+	t, err := s.Authorizer.Authorize(stream.RequestHeader().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	if !stream.Receive() {
+		if err := stream.Err(); err != nil {
+			s.Logger.ErrorContext(ctx, "failed to receive request", "error", err.Error())
+			return nil, err
+		}
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("key is required"))
+	}
+
+	first := stream.Msg()
+	if first.GetKey() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("key is required"))
+	}
+
+	internalKey, _, err := keygen.FromToken(*t, first.GetDatabase(), first.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	id, err := newLeaseID()
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create object id", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create object id: %w", err))
+	}
+
+	name := internalKey + "/" + id
+
+	start := time.Now()
+
+	// the chunks are written to the object store as they are received, the stream can not be used once the
+	// handler returns so the goroutine is always waited for
+	pr, pw := io.Pipe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		if _, err := pw.Write(first.GetData()); err != nil {
+			return
+		}
+
+		for stream.Receive() {
+			if _, err := pw.Write(stream.Msg().GetData()); err != nil {
+				return
+			}
+		}
+
+		pw.CloseWithError(stream.Err())
+	}()
+
+	size, err := s.Objects.Put(ctx, name, pr)
+	pr.CloseWithError(err)
+	<-done
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to put object", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to put object: %w", err))
+	}
+
+	var ttl int64
+	if first.GetTtl() > 0 {
+		ttl = time.Now().Add(time.Duration(first.GetTtl()) * time.Second).Unix()
+	}
+
+	i := storage.Item{
+		TTL:             ttl,
+		ContentType:     first.GetContentType(),
+		ContentEncoding: first.GetContentEncoding(),
+		Metadata:        first.GetMetadata(),
+		Object:          name,
+		ObjectSize:      size,
+	}
+
+	revision, err := s.write(ctx, *t, first.GetDatabase(), internalKey, i, cachev1.SetCondition_SET_CONDITION_UNSPECIFIED, 0)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to set key", "error", err.Error())
+		s.removeObject(ctx, &storage.Entry{Item: i})
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to set key: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "put object", "key", internalKey, "size", size, "duration", time.Since(start).String())
+
+	return connect.NewResponse(&cachev1.PutObjectResponse{
+		Key:      first.GetKey(),
+		Size:     size,
+		Ttl:      ttl,
+		Revision: revision,
+	}), nil
+}
+
+// GetObject streams the value of the key in chunks. Values stored with Set are sent in a single message.
+func (s *server) GetObject(ctx context.Context, req *connect.Request[cachev1.GetObjectRequest], stream *connect.ServerStream[cachev1.GetObjectResponse]) error {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.FromToken(*t, req.Msg.GetDatabase(), req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	start := time.Now()
+	e, err := s.get(ctx, internalKey, &cachev1.GetRequest{})
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to get key", "error", err.Error())
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get key: %w", err))
+	}
+
+	if e == nil {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("key not found"))
+	}
+
+	resp := &cachev1.GetObjectResponse{
+		Key:             req.Msg.GetKey(),
+		Size:            uint64(len(e.Value)),
+		Ttl:             e.TTL,
+		Revision:        e.Revision,
+		ContentType:     e.ContentType,
+		ContentEncoding: e.ContentEncoding,
+		Metadata:        e.Metadata,
+	}
+
+	if e.Object == "" {
+		resp.Data = e.Value
+
+		return stream.Send(resp)
+	}
+
+	resp.Size = e.ObjectSize
+
+	r, err := s.Objects.Get(ctx, e.Object)
+	if err != nil {
+		// the object is removed when the key is overwritten after it was read
+		if errors.Is(err, storage.ErrKeyNotFound) {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("key not found"))
+		}
+
+		s.Logger.ErrorContext(ctx, "failed to get object", "error", err.Error())
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get object: %w", err))
+	}
+	defer r.Close()
+
+	buf := make([]byte, objectChunkSize)
+	sent := false
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			resp.Data = buf[:n]
+			if err := stream.Send(resp); err != nil {
+				s.Logger.ErrorContext(ctx, "failed to send response", "error", err.Error())
+				return err
+			}
+
+			sent = true
+			resp = &cachev1.GetObjectResponse{}
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+
+		if err != nil {
+			s.Logger.ErrorContext(ctx, "failed to read object", "error", err.Error())
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read object: %w", err))
+		}
+	}
+
+	// an empty object still sends the description of the value
+	if !sent {
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+
+	s.Logger.DebugContext(ctx, "get object", "key", internalKey, "size", e.ObjectSize, "duration", time.Since(start).String())
+
+	return nil
+}
+
+// removeObject removes the object linked from the entry if there is one. Errors are only logged since the
+// key no longer links to the object and it is removed by the sweep.
+func (k *Keyspace) removeObject(ctx context.Context, e *storage.Entry) {
+	if e == nil || e.Object == "" {
+		return
+	}
+
+	if err := k.Objects.Delete(ctx, e.Object); err != nil {
+		k.Logger.ErrorContext(ctx, "failed to remove object", "name", e.Object, "error", err.Error())
+	}
+}
@@ -0,0 +1,107 @@
+package cached
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+func TestServer_objectValues(t *testing.T) {
+	s := newServer(t)
+
+	if _, err := s.Store.Set(context.TODO(), "alice.0-big", storage.Item{Object: "alice.0-big/1", ObjectSize: 1 << 20}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Get(context.TODO(), request(&cachev1.GetRequest{Key: "big"})); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("Get() error = %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+
+	if _, err := s.GetOrLease(context.TODO(), request(&cachev1.GetOrLeaseRequest{Key: "big"})); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("GetOrLease() error = %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+
+	resp, err := s.GetMulti(context.TODO(), request(&cachev1.GetMultiRequest{Keys: []string{"big", "missing"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if items := resp.Msg.GetItems(); items[0].GetError() == "" || items[1].GetError() != "" {
+		t.Errorf("GetMulti() = %v, want an error for the object only", items)
+	}
+}
+
+func TestServer_objectValues_atomic(t *testing.T) {
+	s := newServer(t)
+
+	if _, err := s.Objects.Put(context.TODO(), "alice.0-big/1", strings.NewReader("large")); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Store.Set(context.TODO(), "alice.0-big", storage.Item{Object: "alice.0-big/1", ObjectSize: 5}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.GetAndDelete(context.TODO(), request(&cachev1.GetAndDeleteRequest{Key: "big"})); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("GetAndDelete() error = %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+
+	if _, err := s.GetAndSet(context.TODO(), request(&cachev1.GetAndSetRequest{Key: "big", Value: []byte("small")})); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("GetAndSet() error = %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+
+	// the key still links to the object and the object was kept
+	e, err := s.Store.Get(context.TODO(), "alice.0-big")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if e == nil || e.Object != "alice.0-big/1" {
+		t.Fatalf("Get() = %v, want the key to link to the object", e)
+	}
+
+	r, err := s.Objects.Get(context.TODO(), "alice.0-big/1")
+	if err != nil {
+		t.Fatalf("object was removed: %v", err)
+	}
+	r.Close()
+}
+
+func TestServer_GetAndSet(t *testing.T) {
+	s := newServer(t)
+
+	if _, err := s.Set(context.TODO(), request(&cachev1.SetRequest{Key: "a", Value: []byte("a"), Tags: []string{"users"}})); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := s.GetAndSet(context.TODO(), request(&cachev1.GetAndSetRequest{Key: "a", Value: []byte("b")}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !resp.Msg.GetExists() || string(resp.Msg.GetValue()) != "a" || resp.Msg.GetRevision() == 0 {
+		t.Errorf("GetAndSet() = %v, want the previous value", resp.Msg)
+	}
+
+	del, err := s.GetAndDelete(context.TODO(), request(&cachev1.GetAndDeleteRequest{Key: "a"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !del.Msg.GetDeleted() || string(del.Msg.GetValue()) != "b" {
+		t.Errorf("GetAndDelete() = %v, want the value set by GetAndSet()", del.Msg)
+	}
+
+	del, err = s.GetAndDelete(context.TODO(), request(&cachev1.GetAndDeleteRequest{Key: "a"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if del.Msg.GetDeleted() {
+		t.Errorf("GetAndDelete() of a deleted key = %v, want not deleted", del.Msg)
+	}
+}
@@ -0,0 +1,84 @@
+package cached
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"connectrpc.com/connect"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/keygen"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+// RateLimit consumes the cost from the named limiter if the limit allows it.
+func (s *server) RateLimit(ctx context.Context, req *connect.Request[cachev1.RateLimitRequest]) (*connect.Response[cachev1.RateLimitResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	if req.Msg.GetName() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+	}
+
+	if req.Msg.GetLimit() == 0 || req.Msg.GetLimit() > math.MaxInt64 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("limit must be greater than 0"))
+	}
+
+	if req.Msg.GetWindow() == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("window must be greater than 0"))
+	}
+
+	cost := uint64(1)
+	if req.Msg.Cost != nil {
+		cost = req.Msg.GetCost()
+	}
+
+	if cost > req.Msg.GetLimit() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, storage.ErrCostExceedsLimit)
+	}
+
+	internalKey, name, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "ratelimit", req.Msg.GetName())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	l := storage.Limit{
+		Algorithm: storage.AlgorithmTokenBucket,
+		Limit:     int64(req.Msg.GetLimit()),
+		Window:    time.Duration(req.Msg.GetWindow()) * time.Millisecond,
+	}
+
+	if req.Msg.GetAlgorithm() == cachev1.RateLimitAlgorithm_RATE_LIMIT_ALGORITHM_SLIDING_WINDOW {
+		l.Algorithm = storage.AlgorithmSlidingWindow
+	}
+
+	start := time.Now()
+	result, err := storage.RateLimit(ctx, s.Store, internalKey, l, int64(cost), start)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to check rate limit", "error", err.Error())
+
+		if errors.Is(err, storage.ErrCostExceedsLimit) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check rate limit: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "rate limit", "key", internalKey, "allowed", result.Allowed, "remaining", result.Remaining, "duration", time.Since(start).String())
+
+	// retry after is rounded up so the client never retries too early
+	retryAfter := (result.RetryAfter + time.Millisecond - 1) / time.Millisecond
+
+	return connect.NewResponse(&cachev1.RateLimitResponse{
+		Name:       name,
+		Allowed:    result.Allowed,
+		Remaining:  uint64(result.Remaining),
+		RetryAfter: uint32(min(retryAfter, math.MaxUint32)),
+	}), nil
+}
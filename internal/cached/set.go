@@ -0,0 +1,171 @@
+package cached
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/keygen"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+// SAdd adds the members to the set at the key.
+func (s *server) SAdd(ctx context.Context, req *connect.Request[cachev1.SAddRequest]) (*connect.Response[cachev1.SAddResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	if len(req.Msg.GetMembers()) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at least one member is required"))
+	}
+
+	internalKey, _, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "set", req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	// did the user provide a value for the ttl?
+	var ttl int64
+	if req.Msg.GetTtl() > 0 {
+		ttl = time.Now().Add(time.Duration(req.Msg.GetTtl()) * time.Second).Unix()
+	}
+
+	start := time.Now()
+	added, err := storage.SetAdd(ctx, s.Store, internalKey, req.Msg.GetMembers(), ttl)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to add set members", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to add set members: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "sadd", "key", internalKey, "added", added, "duration", time.Since(start).String())
+
+	return connect.NewResponse(&cachev1.SAddResponse{
+		Key:   req.Msg.GetKey(),
+		Added: uint32(added),
+	}), nil
+}
+
+// SRem removes the members from the set at the key.
+func (s *server) SRem(ctx context.Context, req *connect.Request[cachev1.SRemRequest]) (*connect.Response[cachev1.SRemResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "set", req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	start := time.Now()
+	removed, err := storage.SetRemove(ctx, s.Store, internalKey, req.Msg.GetMembers())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to remove set members", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to remove set members: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "srem", "key", internalKey, "removed", removed, "duration", time.Since(start).String())
+
+	return connect.NewResponse(&cachev1.SRemResponse{
+		Key:     req.Msg.GetKey(),
+		Removed: uint32(removed),
+	}), nil
+}
+
+// SIsMember checks if the member is in the set at the key.
+func (s *server) SIsMember(ctx context.Context, req *connect.Request[cachev1.SIsMemberRequest]) (*connect.Response[cachev1.SIsMemberResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "set", req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	start := time.Now()
+	members, _, err := storage.SetGet(ctx, s.Store, internalKey)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to get set", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get set: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "sismember", "key", internalKey, "duration", time.Since(start).String())
+
+	_, ok := members[req.Msg.GetMember()]
+
+	return connect.NewResponse(&cachev1.SIsMemberResponse{
+		Key:      req.Msg.GetKey(),
+		Member:   req.Msg.GetMember(),
+		IsMember: ok,
+	}), nil
+}
+
+// SMembers returns every member of the set at the key.
+func (s *server) SMembers(ctx context.Context, req *connect.Request[cachev1.SMembersRequest]) (*connect.Response[cachev1.SMembersResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "set", req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	start := time.Now()
+	members, ttl, err := storage.SetGet(ctx, s.Store, internalKey)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to get set", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get set: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "smembers", "key", internalKey, "count", len(members), "duration", time.Since(start).String())
+
+	return connect.NewResponse(&cachev1.SMembersResponse{
+		Key:     req.Msg.GetKey(),
+		Members: members.Members(),
+		Ttl:     ttl,
+	}), nil
+}
+
+// SCard returns the number of members in the set at the key.
+func (s *server) SCard(ctx context.Context, req *connect.Request[cachev1.SCardRequest]) (*connect.Response[cachev1.SCardResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "set", req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	start := time.Now()
+	members, _, err := storage.SetGet(ctx, s.Store, internalKey)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to get set", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get set: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "scard", "key", internalKey, "duration", time.Since(start).String())
+
+	return connect.NewResponse(&cachev1.SCardResponse{
+		Key:   req.Msg.GetKey(),
+		Count: uint64(len(members)),
+	}), nil
+}
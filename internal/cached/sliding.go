@@ -0,0 +1,57 @@
+package cached
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+// slide pushes the expiration of an entry with a sliding window forward and updates the entry with the
+// ttl to send to the client. The expiration is only pushed forward once less than half of the window
+// remains so most reads do not write at all. The new ttl is written in the background so reads are not
+// slowed down by the write, a soft ttl is pushed forward by the same amount so the value does not turn
+// stale for good after the first window. The write creates a new revision of the key, the entry keeps the
+// revision that was read. If the key is written before the refresh is stored the refresh is dropped since
+// the new write set its own ttl.
+func (k *Keyspace) slide(ctx context.Context, internalKey string, e *storage.Entry) {
+	if e.Sliding == 0 {
+		return
+	}
+
+	now := time.Now()
+	if (e.TTL-now.Unix())*2 >= e.Sliding {
+		return
+	}
+
+	ttl := now.Add(time.Duration(e.Sliding) * time.Second).Unix()
+
+	i := e.Item
+	if i.SoftTTL > 0 {
+		i.SoftTTL += ttl - i.TTL
+	}
+	i.TTL = ttl
+
+	e.TTL, e.SoftTTL = i.TTL, i.SoftTTL
+
+	// concurrent reads of the same key only write one refresh
+	if _, loaded := k.sliding.LoadOrStore(internalKey, struct{}{}); loaded {
+		return
+	}
+
+	go func(ctx context.Context) {
+		defer k.sliding.Delete(internalKey)
+
+		if _, err := k.Store.Update(ctx, internalKey, i, e.Revision); err != nil {
+			if errors.Is(err, storage.ErrRevisionMismatch) {
+				return
+			}
+
+			k.Logger.ErrorContext(ctx, "failed to refresh sliding expiration", "key", internalKey, "error", err.Error())
+			return
+		}
+
+		k.Logger.DebugContext(ctx, "refreshed sliding expiration", "key", internalKey, "ttl", ttl)
+	}(context.WithoutCancel(ctx))
+}
@@ -0,0 +1,158 @@
+package cached
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+func TestServer_slide(t *testing.T) {
+	s := newServer(t)
+
+	// less than half of the sliding window remains so the next read pushes it forward
+	written, err := s.Store.Set(context.TODO(), "alice.0-a", storage.Item{Value: []byte("a"), TTL: time.Now().Unix() + 10, Sliding: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := s.Get(context.TODO(), request(&cachev1.GetRequest{Key: "a"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Msg.GetTtl() < time.Now().Unix()+99 {
+		t.Errorf("Get() ttl = %d, want the expiration pushed forward", resp.Msg.GetTtl())
+	}
+
+	if resp.Msg.GetRevision() != written {
+		t.Errorf("Get() revision = %d, want the revision that was read %d", resp.Msg.GetRevision(), written)
+	}
+
+	// the refresh is written in the background
+	e := waitFor(t, s.Store, "alice.0-a", func(e *storage.Entry) bool { return e.Revision != written })
+	if e.TTL != resp.Msg.GetTtl() {
+		t.Errorf("refreshed ttl = %d, want %d", e.TTL, resp.Msg.GetTtl())
+	}
+
+	// more than half of the window remains so the read does not write
+	if _, err := s.Get(context.TODO(), request(&cachev1.GetRequest{Key: "a"})); err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(10 * time.Millisecond)
+
+	after, err := s.Store.Get(context.TODO(), "alice.0-a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if after.Revision != e.Revision {
+		t.Errorf("revision after a read within the window = %d, want %d", after.Revision, e.Revision)
+	}
+}
+
+// waitFor returns the entry of the key once ok returns true for it, the test fails if it takes longer
+// than a second.
+func waitFor(t *testing.T, s storage.Store, key string, ok func(e *storage.Entry) bool) *storage.Entry {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		e, err := s.Get(context.TODO(), key)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if e != nil && ok(e) {
+			return e
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("Get(%s) = %v, timed out waiting for the entry", key, e)
+		}
+
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestServer_Touch_sliding(t *testing.T) {
+	s := newServer(t)
+
+	if _, err := s.Set(context.TODO(), request(&cachev1.SetRequest{Key: "a", Value: []byte("a"), Ttl: ptr[uint32](100), Sliding: true})); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Touch(context.TODO(), request(&cachev1.TouchRequest{Key: "a", Ttl: 10})); err != nil {
+		t.Fatal(err)
+	}
+
+	// the read does not push the expiration set by touch back out to the sliding window
+	resp, err := s.Get(context.TODO(), request(&cachev1.GetRequest{Key: "a"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := time.Now().Unix() + 10; resp.Msg.GetTtl() > want {
+		t.Errorf("Get() after Touch() ttl = %d, want at most %d", resp.Msg.GetTtl(), want)
+	}
+
+	e, err := s.Store.Get(context.TODO(), "alice.0-a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if e.Sliding != 0 {
+		t.Errorf("Touch() sliding = %d, want 0", e.Sliding)
+	}
+}
+
+func TestServer_Persist_sliding(t *testing.T) {
+	s := newServer(t)
+
+	if _, err := s.Set(context.TODO(), request(&cachev1.SetRequest{Key: "a", Value: []byte("a"), Ttl: ptr[uint32](100), Sliding: true})); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Persist(context.TODO(), request(&cachev1.PersistRequest{Key: "a"})); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := s.Get(context.TODO(), request(&cachev1.GetRequest{Key: "a"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Msg.GetTtl() != 0 {
+		t.Errorf("Get() after Persist() ttl = %d, want 0", resp.Msg.GetTtl())
+	}
+}
+
+func TestServer_slide_softTTL(t *testing.T) {
+	s := newServer(t)
+
+	now := time.Now().Unix()
+	if _, err := s.Store.Set(context.TODO(), "alice.0-a", storage.Item{Value: []byte("a"), TTL: now + 10, SoftTTL: now + 5, Sliding: 100}); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := s.GetMulti(context.TODO(), request(&cachev1.GetMultiRequest{Keys: []string{"a"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item := resp.Msg.GetItems()[0]
+	if got, want := item.GetTtl()-item.GetSoftTtl(), int64(5); got != want {
+		t.Errorf("GetMulti() soft_ttl is %d seconds before the ttl, want %d", got, want)
+	}
+
+	if item.GetFreshness() != cachev1.Freshness_FRESHNESS_FRESH {
+		t.Errorf("GetMulti() freshness = %v, want %v", item.GetFreshness(), cachev1.Freshness_FRESHNESS_FRESH)
+	}
+}
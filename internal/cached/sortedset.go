@@ -0,0 +1,200 @@
+package cached
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"connectrpc.com/connect"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/keygen"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+// ZAdd sets the score of the members in the sorted set at the key.
+func (s *server) ZAdd(ctx context.Context, req *connect.Request[cachev1.ZAddRequest]) (*connect.Response[cachev1.ZAddResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	if len(req.Msg.GetMembers()) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at least one member is required"))
+	}
+
+	for m, score := range req.Msg.GetMembers() {
+		if math.IsNaN(score) || math.IsInf(score, 0) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("score of %q is not a finite number", m))
+		}
+	}
+
+	internalKey, _, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "zset", req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	// did the user provide a value for the ttl?
+	var ttl int64
+	if req.Msg.GetTtl() > 0 {
+		ttl = time.Now().Add(time.Duration(req.Msg.GetTtl()) * time.Second).Unix()
+	}
+
+	start := time.Now()
+	added, err := storage.SortedSetAdd(ctx, s.Store, internalKey, req.Msg.GetMembers(), ttl)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to add sorted set members", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to add sorted set members: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "zadd", "key", internalKey, "added", added, "duration", time.Since(start).String())
+
+	return connect.NewResponse(&cachev1.ZAddResponse{
+		Key:   req.Msg.GetKey(),
+		Added: uint32(added),
+	}), nil
+}
+
+// ZIncrBy adds the delta (or 1 if not provided) to the score of the member in the sorted set at the key.
+func (s *server) ZIncrBy(ctx context.Context, req *connect.Request[cachev1.ZIncrByRequest]) (*connect.Response[cachev1.ZIncrByResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	delta := float64(1)
+	if req.Msg.Delta != nil {
+		delta = req.Msg.GetDelta()
+	}
+
+	if math.IsNaN(delta) || math.IsInf(delta, 0) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("delta is not a finite number"))
+	}
+
+	internalKey, _, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "zset", req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	// did the user provide a value for the ttl?
+	var ttl int64
+	if req.Msg.GetTtl() > 0 {
+		ttl = time.Now().Add(time.Duration(req.Msg.GetTtl()) * time.Second).Unix()
+	}
+
+	start := time.Now()
+	score, err := storage.SortedSetIncrement(ctx, s.Store, internalKey, req.Msg.GetMember(), delta, ttl)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to increment sorted set member", "error", err.Error())
+
+		if errors.Is(err, storage.ErrOverflow) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to increment sorted set member: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "zincrby", "key", internalKey, "member", req.Msg.GetMember(), "delta", delta, "duration", time.Since(start).String())
+
+	return connect.NewResponse(&cachev1.ZIncrByResponse{
+		Key:    req.Msg.GetKey(),
+		Member: req.Msg.GetMember(),
+		Score:  score,
+	}), nil
+}
+
+// ZRange returns the members of the sorted set at the key by rank, or by score if a min or max score
+// is provided.
+func (s *server) ZRange(ctx context.Context, req *connect.Request[cachev1.ZRangeRequest]) (*connect.Response[cachev1.ZRangeResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "zset", req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	start := time.Now()
+
+	var members []storage.ScoredMember
+	if req.Msg.Min != nil || req.Msg.Max != nil {
+		low, high := math.Inf(-1), math.Inf(1)
+		if req.Msg.Min != nil {
+			low = req.Msg.GetMin()
+		}
+
+		if req.Msg.Max != nil {
+			high = req.Msg.GetMax()
+		}
+
+		members, err = storage.SortedSetRangeByScore(ctx, s.Store, internalKey, low, high, req.Msg.GetReverse())
+	} else {
+		members, err = storage.SortedSetRangeByRank(ctx, s.Store, internalKey, req.Msg.GetStart(), req.Msg.GetStop(), req.Msg.GetReverse())
+	}
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to get sorted set", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get sorted set: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "zrange", "key", internalKey, "count", len(members), "duration", time.Since(start).String())
+
+	resp := &cachev1.ZRangeResponse{
+		Key:     req.Msg.GetKey(),
+		Members: make([]*cachev1.ScoredMember, len(members)),
+	}
+
+	for i, m := range members {
+		resp.Members[i] = &cachev1.ScoredMember{
+			Member: m.Member,
+			Score:  m.Score,
+		}
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// ZRank returns the rank of the member in the sorted set at the key.
+func (s *server) ZRank(ctx context.Context, req *connect.Request[cachev1.ZRankRequest]) (*connect.Response[cachev1.ZRankResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.ForKind(*t, req.Msg.GetDatabase(), "zset", req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	start := time.Now()
+	rank, score, err := storage.SortedSetRank(ctx, s.Store, internalKey, req.Msg.GetMember(), req.Msg.GetReverse())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to get sorted set", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get sorted set: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "zrank", "key", internalKey, "member", req.Msg.GetMember(), "duration", time.Since(start).String())
+
+	resp := &cachev1.ZRankResponse{
+		Key:    req.Msg.GetKey(),
+		Member: req.Msg.GetMember(),
+	}
+
+	if rank >= 0 {
+		resp.Exists = true
+		resp.Rank = uint64(rank)
+		resp.Score = score
+	}
+
+	return connect.NewResponse(resp), nil
+}
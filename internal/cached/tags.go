@@ -0,0 +1,145 @@
+package cached
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jasonmccallister/nats-cache/internal/auth"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/keygen"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+// InvalidateTags deletes every key with any of the tags. The keys are found using the index of each tag,
+// the index may include keys that expired, were removed or were overwritten without the tag so every key
+// is checked before it is deleted and those keys are removed from the index.
+func (s *server) InvalidateTags(ctx context.Context, req *connect.Request[cachev1.InvalidateTagsRequest]) (*connect.Response[cachev1.InvalidateTagsResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	start := time.Now()
+
+	var deleted uint64
+	for _, tag := range req.Msg.GetTags() {
+		index, err := tagIndex(*t, req.Msg.GetDatabase(), tag)
+		if err != nil {
+			s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+		}
+
+		keys, _, err := storage.SetGet(ctx, s.Store, index)
+		if err != nil {
+			s.Logger.ErrorContext(ctx, "failed to get tag index", "error", err.Error())
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get tag index: %w", err))
+		}
+
+		var removed []string
+		for _, key := range keys.Members() {
+			e, err := s.Store.Get(ctx, key)
+			if err != nil {
+				s.Logger.ErrorContext(ctx, "failed to get key", "error", err.Error())
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get key: %w", err))
+			}
+
+			// the key was removed or overwritten without the tag
+			if e == nil || !e.HasTag(tag) {
+				removed = append(removed, key)
+				continue
+			}
+
+			// the key is expired using the revision it was read at so a value written after the key was
+			// checked is never removed
+			_, err = s.Store.Update(ctx, key, storage.Item{TTL: time.Now().Add(-time.Second).Unix()}, e.Revision)
+			if errors.Is(err, storage.ErrRevisionMismatch) {
+				continue
+			}
+
+			if err != nil {
+				s.Logger.ErrorContext(ctx, "failed to delete key", "error", err.Error())
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete key: %w", err))
+			}
+
+			deleted++
+			removed = append(removed, key)
+
+			for _, other := range e.Tags {
+				if other != tag {
+					s.unindex(ctx, *t, req.Msg.GetDatabase(), key, other)
+				}
+			}
+
+			s.removeObject(ctx, e)
+		}
+
+		if _, err := storage.SetRemove(ctx, s.Store, index, removed); err != nil {
+			s.Logger.ErrorContext(ctx, "failed to update tag index", "error", err.Error())
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update tag index: %w", err))
+		}
+	}
+
+	s.Logger.DebugContext(ctx, "invalidate tags", "tags", req.Msg.GetTags(), "deleted", deleted, "duration", time.Since(start).String())
+
+	return connect.NewResponse(&cachev1.InvalidateTagsResponse{
+		Deleted: deleted,
+	}), nil
+}
+
+// tagIndex returns the key of the index of the tag. Tags are encoded since they can have characters that are
+// not valid in a key, such as the colon in product:42.
+func tagIndex(t auth.Token, db uint32, tag string) (string, error) {
+	index, _, err := keygen.ForKind(t, db, "tag", base64.RawURLEncoding.EncodeToString([]byte(tag)))
+
+	return index, err
+}
+
+// index adds the key to the index of every tag. The key is indexed before the item is written so a key is
+// never written with a tag that can not invalidate it, a key in the index that was not written is skipped
+// by InvalidateTags.
+func (k *Keyspace) index(ctx context.Context, t auth.Token, db uint32, internalKey string, tags []string) error {
+	for _, tag := range tags {
+		index, err := tagIndex(t, db, tag)
+		if err != nil {
+			return err
+		}
+
+		if err := storage.TagAdd(ctx, k.Store, index, internalKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reindex removes the key from the index of the tags of the previous entry that the item no longer has.
+func (k *Keyspace) reindex(ctx context.Context, t auth.Token, db uint32, internalKey string, i storage.Item, prev *storage.Entry) {
+	if prev == nil {
+		return
+	}
+
+	for _, tag := range prev.Tags {
+		if !i.HasTag(tag) {
+			k.unindex(ctx, t, db, internalKey, tag)
+		}
+	}
+}
+
+// unindex removes the key from the index of the tag. Errors are only logged since the key is checked
+// before it is deleted by InvalidateTags.
+func (k *Keyspace) unindex(ctx context.Context, t auth.Token, db uint32, internalKey string, tag string) {
+	index, err := tagIndex(t, db, tag)
+	if err != nil {
+		k.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return
+	}
+
+	if _, err := storage.SetRemove(ctx, k.Store, index, []string{internalKey}); err != nil {
+		k.Logger.ErrorContext(ctx, "failed to update tag index", "tag", tag, "error", err.Error())
+	}
+}
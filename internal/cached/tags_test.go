@@ -0,0 +1,102 @@
+package cached
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+func TestServer_InvalidateTags_persisted(t *testing.T) {
+	s := newServer(t)
+
+	if _, err := s.Set(context.TODO(), request(&cachev1.SetRequest{Key: "a", Value: []byte("a"), Ttl: ptr[uint32](1), Tags: []string{"users"}})); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Persist(context.TODO(), request(&cachev1.PersistRequest{Key: "a"})); err != nil {
+		t.Fatal(err)
+	}
+
+	// the index outlives the ttl the key was tagged with
+	index, err := s.Store.Get(context.TODO(), "alice.0.tag-dXNlcnM")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if index == nil || index.TTL != 0 {
+		t.Fatalf("tag index = %v, want an index that does not expire", index)
+	}
+
+	resp, err := s.InvalidateTags(context.TODO(), request(&cachev1.InvalidateTagsRequest{Tags: []string{"users"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Msg.GetDeleted() != 1 {
+		t.Errorf("InvalidateTags() deleted = %d, want 1", resp.Msg.GetDeleted())
+	}
+
+	get, err := s.Get(context.TODO(), request(&cachev1.GetRequest{Key: "a"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if get.Msg.GetValue() != nil {
+		t.Errorf("Get() after InvalidateTags() = %q, want no value", get.Msg.GetValue())
+	}
+}
+
+func TestServer_InvalidateTags_encoded(t *testing.T) {
+	s := newNATSServer(t)
+
+	// tags can have characters that are not valid in a key of the bucket
+	if _, err := s.Set(context.TODO(), request(&cachev1.SetRequest{Key: "a", Value: []byte("a"), Tags: []string{"product:42", "ünïcode tag"}})); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := s.InvalidateTags(context.TODO(), request(&cachev1.InvalidateTagsRequest{Tags: []string{"product:42"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Msg.GetDeleted() != 1 {
+		t.Errorf("InvalidateTags() deleted = %d, want 1", resp.Msg.GetDeleted())
+	}
+}
+
+// failingIndex fails every write to the index of a tag.
+type failingIndex struct {
+	storage.Store
+}
+
+func (f failingIndex) Create(ctx context.Context, key string, i storage.Item) (uint64, error) {
+	if strings.Contains(key, ".tag-") {
+		return 0, fmt.Errorf("unavailable")
+	}
+
+	return f.Store.Create(ctx, key, i)
+}
+
+func TestServer_Set_indexFailure(t *testing.T) {
+	s := newServer(t)
+	s.Store = failingIndex{Store: s.Store}
+
+	// the index of the tag can not be written so the value is not written either
+	if _, err := s.Set(context.TODO(), request(&cachev1.SetRequest{Key: "a", Value: []byte("a"), Tags: []string{"fail"}})); connect.CodeOf(err) != connect.CodeInternal {
+		t.Fatalf("Set() error = %v, want %v", err, connect.CodeInternal)
+	}
+
+	e, err := s.Store.Get(context.TODO(), "alice.0-a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if e != nil {
+		t.Errorf("Get() = %v, want the value to not be written", e)
+	}
+}
@@ -0,0 +1,156 @@
+package cached
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jasonmccallister/nats-cache/internal/auth"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/keygen"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+// Touch sets the key to expire ttl seconds from now.
+func (s *server) Touch(ctx context.Context, req *connect.Request[cachev1.TouchRequest]) (*connect.Response[cachev1.TouchResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	if req.Msg.GetTtl() == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("ttl is required, use Persist to remove the expiration"))
+	}
+
+	ttl := time.Now().Add(time.Duration(req.Msg.GetTtl()) * time.Second).Unix()
+
+	touched, err := s.touch(ctx, *t, req.Msg.GetDatabase(), req.Msg.GetKey(), ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &cachev1.TouchResponse{
+		Key:     req.Msg.GetKey(),
+		Touched: touched,
+	}
+
+	if touched {
+		resp.Ttl = ttl
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// ExpireAt sets the key to expire at the unix time in the request.
+func (s *server) ExpireAt(ctx context.Context, req *connect.Request[cachev1.ExpireAtRequest]) (*connect.Response[cachev1.ExpireAtResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	if req.Msg.GetExpireAt() <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("expire_at is required, use Persist to remove the expiration"))
+	}
+
+	updated, err := s.touch(ctx, *t, req.Msg.GetDatabase(), req.Msg.GetKey(), req.Msg.GetExpireAt())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &cachev1.ExpireAtResponse{
+		Key:     req.Msg.GetKey(),
+		Updated: updated,
+	}
+
+	if updated {
+		resp.Ttl = req.Msg.GetExpireAt()
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// Persist removes the expiration from the key.
+func (s *server) Persist(ctx context.Context, req *connect.Request[cachev1.PersistRequest]) (*connect.Response[cachev1.PersistResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	persisted, err := s.touch(ctx, *t, req.Msg.GetDatabase(), req.Msg.GetKey(), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&cachev1.PersistResponse{
+		Key:       req.Msg.GetKey(),
+		Persisted: persisted,
+	}), nil
+}
+
+// TTL returns the expiration of the key without returning the value.
+func (s *server) TTL(ctx context.Context, req *connect.Request[cachev1.TTLRequest]) (*connect.Response[cachev1.TTLResponse], error) {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	internalKey, _, err := keygen.FromToken(*t, req.Msg.GetDatabase(), req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	start := time.Now()
+	e, err := s.Store.Get(ctx, internalKey)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to get key", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get key: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "ttl", "key", internalKey, "duration", time.Since(start).String())
+
+	resp := &cachev1.TTLResponse{
+		Key: req.Msg.GetKey(),
+	}
+
+	if e != nil {
+		resp.Exists = true
+		resp.Ttl = e.TTL
+
+		if e.TTL > 0 {
+			resp.Remaining = max(e.TTL-time.Now().Unix(), 0)
+		}
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// touch sets the ttl of the key and returns false if the key does not exist. Errors are returned as
+// connect errors.
+func (s *server) touch(ctx context.Context, t auth.Token, db uint32, key string, ttl int64) (bool, error) {
+	internalKey, _, err := keygen.FromToken(t, db, key)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return false, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	start := time.Now()
+	if _, err := s.Store.Touch(ctx, internalKey, ttl); err != nil {
+		if errors.Is(err, storage.ErrKeyNotFound) {
+			return false, nil
+		}
+
+		s.Logger.ErrorContext(ctx, "failed to touch key", "error", err.Error())
+		return false, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to touch key: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "touch", "key", internalKey, "ttl", ttl, "duration", time.Since(start).String())
+
+	return true, nil
+}
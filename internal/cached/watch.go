@@ -0,0 +1,91 @@
+package cached
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/keygen"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+// Watch sends every change to the key, or keys starting with the key, until the client disconnects.
+// Events only include keys for the subject of the token and the internal prefix is removed.
+func (s *server) Watch(ctx context.Context, req *connect.Request[cachev1.WatchRequest], stream *connect.ServerStream[cachev1.WatchResponse]) error {
+	t, err := s.Authorizer.Authorize(req.Header().Get("Authorization"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to authorize request", "error", err.Error())
+		return connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize request: %w", err))
+	}
+
+	if req.Msg.GetKey() == "" && !req.Msg.GetPrefix() {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("key is required unless watching a prefix"))
+	}
+
+	internalKey, _, err := keygen.FromToken(*t, req.Msg.GetDatabase(), req.Msg.GetKey())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	// the prefix for the database is removed from the keys sent to the client
+	dbPrefix, _, err := keygen.FromToken(*t, req.Msg.GetDatabase(), "")
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to create internal key", "error", err.Error())
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create key: %w", err))
+	}
+
+	events, err := s.Store.Watch(ctx, internalKey, req.Msg.GetPrefix())
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to watch key", "error", err.Error())
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to watch key: %w", err))
+	}
+
+	s.Logger.DebugContext(ctx, "watch", "key", internalKey, "prefix", req.Msg.GetPrefix())
+
+	for e := range events {
+		if !strings.HasPrefix(e.Key, dbPrefix) {
+			continue
+		}
+
+		resp := &cachev1.WatchResponse{
+			Key:       strings.TrimPrefix(e.Key, dbPrefix),
+			Operation: watchOperation(e.Operation),
+			Revision:  e.Revision,
+		}
+
+		if e.Operation == storage.OperationPut {
+			resp.Value = e.Value
+			resp.Ttl = e.TTL
+
+			if e.Object != "" {
+				resp.Error = errObject.Error()
+			}
+		}
+
+		if err := stream.Send(resp); err != nil {
+			s.Logger.ErrorContext(ctx, "failed to send response", "error", err.Error())
+			return err
+		}
+	}
+
+	return nil
+}
+
+// watchOperation converts the storage operation into the watch operation sent to clients.
+func watchOperation(op storage.Operation) cachev1.WatchOperation {
+	switch op {
+	case storage.OperationPut:
+		return cachev1.WatchOperation_WATCH_OPERATION_PUT
+	case storage.OperationDelete:
+		return cachev1.WatchOperation_WATCH_OPERATION_DELETE
+	case storage.OperationPurge:
+		return cachev1.WatchOperation_WATCH_OPERATION_PURGE
+	case storage.OperationExpire:
+		return cachev1.WatchOperation_WATCH_OPERATION_EXPIRE
+	}
+
+	return cachev1.WatchOperation_WATCH_OPERATION_UNSPECIFIED
+}
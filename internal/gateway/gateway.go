@@ -0,0 +1,306 @@
+// Package gateway exposes the cache service over plain HTTP so clients such as curl, shell scripts and
+// edge workers can read and write raw values without protobuf shaped bodies.
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/gen/cache/v1/cachev1connect"
+)
+
+const (
+	// Path is the prefix of every route of the gateway
+	Path = "/v1/"
+	// TTLHeader is the header used to pass the ttl in seconds when the ttl query parameter is not used
+	TTLHeader = "Cache-Ttl"
+	// maxBodySize is the largest value accepted by PUT, larger values should use PutObject
+	maxBodySize = 64 * 1024 * 1024
+)
+
+type handler struct {
+	Logger  *slog.Logger
+	Service cachev1connect.CacheServiceHandler
+}
+
+// NewHandler returns a handler that maps the routes below Path onto the cache service. The Authorization
+// header is passed to the service so requests are authorized and scoped the same way as the cache service.
+//
+//	GET    /v1/db/{database}/keys/{key}
+//	PUT    /v1/db/{database}/keys/{key}
+//	DELETE /v1/db/{database}/keys/{key}
+func NewHandler(l *slog.Logger, svc cachev1connect.CacheServiceHandler) http.Handler {
+	h := &handler{
+		Logger:  l,
+		Service: svc,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/db/{database}/keys/{key...}", h.get)
+	mux.HandleFunc("PUT /v1/db/{database}/keys/{key...}", h.put)
+	mux.HandleFunc("DELETE /v1/db/{database}/keys/{key...}", h.delete)
+
+	return mux
+}
+
+func (h *handler) get(w http.ResponseWriter, r *http.Request) {
+	db, ok := h.database(w, r)
+	if !ok {
+		return
+	}
+
+	req := connect.NewRequest(&cachev1.GetRequest{Database: &db, Key: r.PathValue("key")})
+	req.Header().Set("Authorization", r.Header.Get("Authorization"))
+
+	resp, err := h.Service.Get(r.Context(), req)
+	if err != nil {
+		h.error(r.Context(), w, err)
+		return
+	}
+
+	// a key that does not exist has no revision
+	if resp.Msg.GetRevision() == 0 {
+		h.error(r.Context(), w, connect.NewError(connect.CodeNotFound, fmt.Errorf("key not found")))
+		return
+	}
+
+	etag := etag(resp.Msg.GetRevision())
+
+	w.Header().Set("ETag", etag)
+	cacheHeaders(w.Header(), resp.Msg.GetTtl(), resp.Msg.GetSoftTtl())
+
+	if match(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	contentType := resp.Msg.GetContentType()
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(resp.Msg.GetValue())))
+	if v := resp.Msg.GetContentEncoding(); v != "" {
+		w.Header().Set("Content-Encoding", v)
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if r.Method != http.MethodHead {
+		w.Write(resp.Msg.GetValue())
+	}
+}
+
+// put stores the body as the value. If-Match with the ETag of the value only writes the value if it was not
+// modified, weak ETags are compared as strong like match. If-Match with * only writes the value if the key
+// exists and If-None-Match with * only writes the value if the key does not exist.
+func (h *handler) put(w http.ResponseWriter, r *http.Request) {
+	db, ok := h.database(w, r)
+	if !ok {
+		return
+	}
+
+	msg := &cachev1.SetRequest{
+		Database:        &db,
+		Key:             r.PathValue("key"),
+		ContentType:     r.Header.Get("Content-Type"),
+		ContentEncoding: r.Header.Get("Content-Encoding"),
+	}
+
+	ttl := r.URL.Query().Get("ttl")
+	if ttl == "" {
+		ttl = r.Header.Get(TTLHeader)
+	}
+
+	if ttl != "" {
+		secs, err := strconv.ParseUint(ttl, 10, 32)
+		if err != nil {
+			h.error(r.Context(), w, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("ttl must be a number of seconds")))
+			return
+		}
+
+		v := uint32(secs)
+		msg.Ttl = &v
+	}
+
+	ifMatch := strings.TrimSpace(r.Header.Get("If-Match"))
+
+	switch {
+	case ifMatch == "*":
+		msg.Condition = cachev1.SetCondition_SET_CONDITION_IF_EXISTS
+	case ifMatch != "":
+		revision, err := strconv.ParseUint(strings.Trim(strings.TrimPrefix(ifMatch, "W/"), `"`), 10, 64)
+		if err != nil {
+			h.errorStatus(r.Context(), w, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("revision does not match")), http.StatusPreconditionFailed)
+			return
+		}
+
+		msg.Condition = cachev1.SetCondition_SET_CONDITION_IF_REVISION
+		msg.Revision = &revision
+	case r.Header.Get("If-None-Match") == "*":
+		msg.Condition = cachev1.SetCondition_SET_CONDITION_IF_NOT_EXISTS
+	}
+
+	b, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		var mbe *http.MaxBytesError
+		if errors.As(err, &mbe) {
+			h.error(r.Context(), w, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("value is too large, use PutObject")))
+			return
+		}
+
+		h.error(r.Context(), w, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to read body: %w", err)))
+		return
+	}
+
+	msg.Value = b
+
+	req := connect.NewRequest(msg)
+	req.Header().Set("Authorization", r.Header.Get("Authorization"))
+
+	resp, err := h.Service.Set(r.Context(), req)
+	if err != nil {
+		// a failed condition is reported the way HTTP reports conditional requests
+		code := connect.CodeOf(err)
+		if msg.Condition != cachev1.SetCondition_SET_CONDITION_UNSPECIFIED && (code == connect.CodeFailedPrecondition || code == connect.CodeAlreadyExists) {
+			h.errorStatus(r.Context(), w, err, http.StatusPreconditionFailed)
+			return
+		}
+
+		h.error(r.Context(), w, err)
+		return
+	}
+
+	w.Header().Set("ETag", etag(resp.Msg.GetRevision()))
+	cacheHeaders(w.Header(), resp.Msg.GetTtl(), 0)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *handler) delete(w http.ResponseWriter, r *http.Request) {
+	db, ok := h.database(w, r)
+	if !ok {
+		return
+	}
+
+	req := connect.NewRequest(&cachev1.DeleteRequest{Database: &db, Key: r.PathValue("key")})
+	req.Header().Set("Authorization", r.Header.Get("Authorization"))
+
+	// deleting a key that does not exist succeeds like the cache service
+	if _, err := h.Service.Delete(r.Context(), req); err != nil {
+		h.error(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// error writes the error as JSON in the same shape as connect errors with a matching status code.
+func (h *handler) error(ctx context.Context, w http.ResponseWriter, err error) {
+	h.errorStatus(ctx, w, err, status(connect.CodeOf(err)))
+}
+
+func (h *handler) errorStatus(ctx context.Context, w http.ResponseWriter, err error, statusCode int) {
+	code := connect.CodeOf(err)
+	msg := err.Error()
+
+	var ce *connect.Error
+	if errors.As(err, &ce) {
+		msg = ce.Message()
+	}
+
+	if code == connect.CodeInternal || code == connect.CodeUnknown {
+		h.Logger.ErrorContext(ctx, "failed to handle gateway request", "error", err.Error())
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	json.NewEncoder(w).Encode(map[string]string{"code": code.String(), "message": msg})
+}
+
+// database returns the database from the path and writes an error if it is not a number.
+func (h *handler) database(w http.ResponseWriter, r *http.Request) (uint32, bool) {
+	db, err := strconv.ParseUint(r.PathValue("database"), 10, 32)
+	if err != nil {
+		h.error(r.Context(), w, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("database must be a number")))
+		return 0, false
+	}
+
+	return uint32(db), true
+}
+
+// cacheHeaders sets Cache-Control and Expires from the ttl. A value with a soft ttl may be cached until it
+// is stale and served while it is revalidated until the ttl.
+func cacheHeaders(h http.Header, ttl, softTTL int64) {
+	if ttl == 0 {
+		h.Set("Cache-Control", "no-cache")
+		return
+	}
+
+	now := time.Now().Unix()
+	maxAge := max(ttl-now, 0)
+
+	if softTTL > 0 && softTTL < ttl {
+		fresh := max(softTTL-now, 0)
+		h.Set("Cache-Control", fmt.Sprintf("max-age=%d, stale-while-revalidate=%d", fresh, maxAge-fresh))
+	} else {
+		h.Set("Cache-Control", fmt.Sprintf("max-age=%d", maxAge))
+	}
+
+	h.Set("Expires", time.Unix(ttl, 0).UTC().Format(http.TimeFormat))
+}
+
+func etag(revision uint64) string {
+	return `"` + strconv.FormatUint(revision, 10) + `"`
+}
+
+// match reports whether the If-None-Match header matches the ETag, weak ETags are compared as strong since
+// the revision always changes with the value.
+func match(header, etag string) bool {
+	for _, v := range strings.Split(header, ",") {
+		v = strings.TrimPrefix(strings.TrimSpace(v), "W/")
+		if v == "*" || v == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// status returns the HTTP status code for the connect error code.
+func status(code connect.Code) int {
+	switch code {
+	case connect.CodeInvalidArgument, connect.CodeOutOfRange:
+		return http.StatusBadRequest
+	case connect.CodeUnauthenticated:
+		return http.StatusUnauthorized
+	case connect.CodePermissionDenied:
+		return http.StatusForbidden
+	case connect.CodeNotFound:
+		return http.StatusNotFound
+	case connect.CodeAlreadyExists, connect.CodeFailedPrecondition, connect.CodeAborted:
+		return http.StatusConflict
+	case connect.CodeResourceExhausted:
+		return http.StatusRequestEntityTooLarge
+	case connect.CodeUnimplemented:
+		return http.StatusNotImplemented
+	case connect.CodeUnavailable:
+		return http.StatusServiceUnavailable
+	case connect.CodeDeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case connect.CodeCanceled:
+		return 499
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,181 @@
+package gateway
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jasonmccallister/nats-cache/internal/auth"
+	"github.com/jasonmccallister/nats-cache/internal/cached"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+// subjectAuthorizer uses the token as the subject and rejects the token "invalid".
+type subjectAuthorizer struct{}
+
+func (subjectAuthorizer) Authorize(token string) (*auth.Token, error) {
+	subject := strings.TrimPrefix(token, "Bearer ")
+	if subject == "" || subject == "invalid" {
+		return nil, fmt.Errorf("invalid token")
+	}
+
+	return &auth.Token{Subject: subject}, nil
+}
+
+func newHandler() http.Handler {
+	l := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	return NewHandler(l, cached.NewServer(l, subjectAuthorizer{}, storage.NewInMemory(), storage.NewInMemoryObjects()))
+}
+
+func do(t *testing.T, h http.Handler, method, target, body string, headers map[string]string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	r.Header.Set("Authorization", "Bearer alice")
+	for k, v := range headers {
+		r.Header.Set(k, v)
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	return w
+}
+
+func TestHandler(t *testing.T) {
+	h := newHandler()
+
+	w := do(t, h, http.MethodGet, "/v1/db/0/keys/a", "", nil)
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("GET missing key = %d, want %d", w.Code, http.StatusNotFound)
+	}
+
+	w = do(t, h, http.MethodPut, "/v1/db/0/keys/a/b?ttl=100", `{"a":1}`, map[string]string{"Content-Type": "application/json"})
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("PUT = %d, want %d: %s", w.Code, http.StatusNoContent, w.Body.String())
+	}
+
+	etag := w.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("PUT did not return an ETag")
+	}
+
+	w = do(t, h, http.MethodGet, "/v1/db/0/keys/a/b", "", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET = %d, want %d", w.Code, http.StatusOK)
+	}
+	if got := w.Body.String(); got != `{"a":1}` {
+		t.Errorf("GET body = %q, want the value", got)
+	}
+	if got := w.Header().Get("Content-Type"); got != "application/json" {
+		t.Errorf("GET Content-Type = %q, want application/json", got)
+	}
+	if got := w.Header().Get("Cache-Control"); got != "max-age=100" && got != "max-age=99" {
+		t.Errorf("GET Cache-Control = %q, want max-age=100", got)
+	}
+	if w.Header().Get("Expires") == "" {
+		t.Error("GET did not set Expires")
+	}
+	if got := w.Header().Get("ETag"); got != etag {
+		t.Errorf("GET ETag = %q, want %q", got, etag)
+	}
+
+	w = do(t, h, http.MethodGet, "/v1/db/0/keys/a/b", "", map[string]string{"If-None-Match": etag})
+	if w.Code != http.StatusNotModified {
+		t.Errorf("GET If-None-Match = %d, want %d", w.Code, http.StatusNotModified)
+	}
+
+	w = do(t, h, http.MethodPut, "/v1/db/0/keys/a/b", "new", map[string]string{"If-Match": `"12345"`})
+	if w.Code != http.StatusPreconditionFailed {
+		t.Errorf("PUT If-Match with an old ETag = %d, want %d", w.Code, http.StatusPreconditionFailed)
+	}
+
+	w = do(t, h, http.MethodPut, "/v1/db/0/keys/a/b", "new", map[string]string{"If-None-Match": "*"})
+	if w.Code != http.StatusPreconditionFailed {
+		t.Errorf("PUT If-None-Match * on an existing key = %d, want %d", w.Code, http.StatusPreconditionFailed)
+	}
+
+	w = do(t, h, http.MethodPut, "/v1/db/0/keys/a/b", "new", map[string]string{"If-Match": etag, TTLHeader: "10"})
+	if w.Code != http.StatusNoContent {
+		t.Errorf("PUT If-Match = %d, want %d", w.Code, http.StatusNoContent)
+	}
+
+	w = do(t, h, http.MethodPut, "/v1/db/0/keys/a/b", "new", map[string]string{"If-Match": "W/" + w.Header().Get("ETag")})
+	if w.Code != http.StatusNoContent {
+		t.Errorf("PUT If-Match with a weak ETag = %d, want %d", w.Code, http.StatusNoContent)
+	}
+
+	w = do(t, h, http.MethodPut, "/v1/db/0/keys/a/b", "new", map[string]string{"If-Match": "*"})
+	if w.Code != http.StatusNoContent {
+		t.Errorf("PUT If-Match * on an existing key = %d, want %d", w.Code, http.StatusNoContent)
+	}
+
+	w = do(t, h, http.MethodPut, "/v1/db/0/keys/missing", "new", map[string]string{"If-Match": "*"})
+	if w.Code != http.StatusPreconditionFailed {
+		t.Errorf("PUT If-Match * on a missing key = %d, want %d", w.Code, http.StatusPreconditionFailed)
+	}
+
+	w = do(t, h, http.MethodGet, "/v1/db/0/keys/a/b", "", nil)
+	if got := w.Body.String(); got != "new" {
+		t.Errorf("GET body = %q, want new", got)
+	}
+	if got := w.Header().Get("Content-Type"); got != "application/octet-stream" {
+		t.Errorf("GET Content-Type = %q, want application/octet-stream", got)
+	}
+
+	// keys are scoped to the database
+	w = do(t, h, http.MethodGet, "/v1/db/1/keys/a/b", "", nil)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("GET in another database = %d, want %d", w.Code, http.StatusNotFound)
+	}
+
+	w = do(t, h, http.MethodDelete, "/v1/db/0/keys/a/b", "", nil)
+	if w.Code != http.StatusNoContent {
+		t.Errorf("DELETE = %d, want %d", w.Code, http.StatusNoContent)
+	}
+
+	w = do(t, h, http.MethodGet, "/v1/db/0/keys/a/b", "", nil)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("GET after DELETE = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestHandler_errors(t *testing.T) {
+	h := newHandler()
+
+	tests := []struct {
+		name    string
+		method  string
+		target  string
+		headers map[string]string
+		want    int
+	}{
+		{name: "invalid database", method: http.MethodGet, target: "/v1/db/x/keys/a", want: http.StatusBadRequest},
+		{name: "invalid ttl", method: http.MethodPut, target: "/v1/db/0/keys/a?ttl=-1", want: http.StatusBadRequest},
+		{name: "invalid token", method: http.MethodGet, target: "/v1/db/0/keys/a", headers: map[string]string{"Authorization": "Bearer invalid"}, want: http.StatusUnauthorized},
+		{name: "unknown route", method: http.MethodGet, target: "/v1/db/0", want: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if w := do(t, h, tt.method, tt.target, "", tt.headers); w.Code != tt.want {
+				t.Errorf("%s %s = %d, want %d", tt.method, tt.target, w.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestCacheHeaders(t *testing.T) {
+	h := http.Header{}
+	cacheHeaders(h, 0, 0)
+	if got := h.Get("Cache-Control"); got != "no-cache" {
+		t.Errorf("Cache-Control without a ttl = %q, want no-cache", got)
+	}
+	if got := h.Get("Expires"); got != "" {
+		t.Errorf("Expires without a ttl = %q, want none", got)
+	}
+}
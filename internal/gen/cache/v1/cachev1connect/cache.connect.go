@@ -21,6 +21,8 @@ import (
 const _ = connect.IsAtLeastVersion1_13_0
 
 const (
+	// LockServiceName is the fully-qualified name of the LockService service.
+	LockServiceName = "cache.v1.LockService"
 	// CacheServiceName is the fully-qualified name of the CacheService service.
 	CacheServiceName = "cache.v1.CacheService"
 )
@@ -33,47 +35,353 @@ const (
 // reflection-formatted method names, remove the leading slash and convert the remaining slash to a
 // period.
 const (
+	// LockServiceAcquireProcedure is the fully-qualified name of the LockService's Acquire RPC.
+	LockServiceAcquireProcedure = "/cache.v1.LockService/Acquire"
+	// LockServiceReleaseProcedure is the fully-qualified name of the LockService's Release RPC.
+	LockServiceReleaseProcedure = "/cache.v1.LockService/Release"
+	// LockServiceRenewProcedure is the fully-qualified name of the LockService's Renew RPC.
+	LockServiceRenewProcedure = "/cache.v1.LockService/Renew"
+	// CacheServiceDecrementProcedure is the fully-qualified name of the CacheService's Decrement RPC.
+	CacheServiceDecrementProcedure = "/cache.v1.CacheService/Decrement"
 	// CacheServiceDeleteProcedure is the fully-qualified name of the CacheService's Delete RPC.
 	CacheServiceDeleteProcedure = "/cache.v1.CacheService/Delete"
+	// CacheServiceDeleteMultiProcedure is the fully-qualified name of the CacheService's DeleteMulti
+	// RPC.
+	CacheServiceDeleteMultiProcedure = "/cache.v1.CacheService/DeleteMulti"
 	// CacheServiceExistsProcedure is the fully-qualified name of the CacheService's Exists RPC.
 	CacheServiceExistsProcedure = "/cache.v1.CacheService/Exists"
+	// CacheServiceExpireAtProcedure is the fully-qualified name of the CacheService's ExpireAt RPC.
+	CacheServiceExpireAtProcedure = "/cache.v1.CacheService/ExpireAt"
+	// CacheServiceFillProcedure is the fully-qualified name of the CacheService's Fill RPC.
+	CacheServiceFillProcedure = "/cache.v1.CacheService/Fill"
 	// CacheServiceGetProcedure is the fully-qualified name of the CacheService's Get RPC.
 	CacheServiceGetProcedure = "/cache.v1.CacheService/Get"
+	// CacheServiceGetAndDeleteProcedure is the fully-qualified name of the CacheService's GetAndDelete
+	// RPC.
+	CacheServiceGetAndDeleteProcedure = "/cache.v1.CacheService/GetAndDelete"
+	// CacheServiceGetAndSetProcedure is the fully-qualified name of the CacheService's GetAndSet RPC.
+	CacheServiceGetAndSetProcedure = "/cache.v1.CacheService/GetAndSet"
+	// CacheServiceGetMetadataProcedure is the fully-qualified name of the CacheService's GetMetadata
+	// RPC.
+	CacheServiceGetMetadataProcedure = "/cache.v1.CacheService/GetMetadata"
 	// CacheServiceGetMultiProcedure is the fully-qualified name of the CacheService's GetMulti RPC.
 	CacheServiceGetMultiProcedure = "/cache.v1.CacheService/GetMulti"
+	// CacheServiceGetObjectProcedure is the fully-qualified name of the CacheService's GetObject RPC.
+	CacheServiceGetObjectProcedure = "/cache.v1.CacheService/GetObject"
+	// CacheServiceGetOrLeaseProcedure is the fully-qualified name of the CacheService's GetOrLease RPC.
+	CacheServiceGetOrLeaseProcedure = "/cache.v1.CacheService/GetOrLease"
 	// CacheServiceGetStreamProcedure is the fully-qualified name of the CacheService's GetStream RPC.
 	CacheServiceGetStreamProcedure = "/cache.v1.CacheService/GetStream"
+	// CacheServiceHDelProcedure is the fully-qualified name of the CacheService's HDel RPC.
+	CacheServiceHDelProcedure = "/cache.v1.CacheService/HDel"
+	// CacheServiceHGetProcedure is the fully-qualified name of the CacheService's HGet RPC.
+	CacheServiceHGetProcedure = "/cache.v1.CacheService/HGet"
+	// CacheServiceHGetAllProcedure is the fully-qualified name of the CacheService's HGetAll RPC.
+	CacheServiceHGetAllProcedure = "/cache.v1.CacheService/HGetAll"
+	// CacheServiceHIncrByProcedure is the fully-qualified name of the CacheService's HIncrBy RPC.
+	CacheServiceHIncrByProcedure = "/cache.v1.CacheService/HIncrBy"
+	// CacheServiceHMGetProcedure is the fully-qualified name of the CacheService's HMGet RPC.
+	CacheServiceHMGetProcedure = "/cache.v1.CacheService/HMGet"
+	// CacheServiceHSetProcedure is the fully-qualified name of the CacheService's HSet RPC.
+	CacheServiceHSetProcedure = "/cache.v1.CacheService/HSet"
+	// CacheServiceHistoryProcedure is the fully-qualified name of the CacheService's History RPC.
+	CacheServiceHistoryProcedure = "/cache.v1.CacheService/History"
+	// CacheServiceIncrementProcedure is the fully-qualified name of the CacheService's Increment RPC.
+	CacheServiceIncrementProcedure = "/cache.v1.CacheService/Increment"
+	// CacheServiceInvalidateTagsProcedure is the fully-qualified name of the CacheService's
+	// InvalidateTags RPC.
+	CacheServiceInvalidateTagsProcedure = "/cache.v1.CacheService/InvalidateTags"
+	// CacheServiceLLenProcedure is the fully-qualified name of the CacheService's LLen RPC.
+	CacheServiceLLenProcedure = "/cache.v1.CacheService/LLen"
+	// CacheServiceLPopProcedure is the fully-qualified name of the CacheService's LPop RPC.
+	CacheServiceLPopProcedure = "/cache.v1.CacheService/LPop"
+	// CacheServiceLPushProcedure is the fully-qualified name of the CacheService's LPush RPC.
+	CacheServiceLPushProcedure = "/cache.v1.CacheService/LPush"
+	// CacheServiceLRangeProcedure is the fully-qualified name of the CacheService's LRange RPC.
+	CacheServiceLRangeProcedure = "/cache.v1.CacheService/LRange"
+	// CacheServiceLTrimProcedure is the fully-qualified name of the CacheService's LTrim RPC.
+	CacheServiceLTrimProcedure = "/cache.v1.CacheService/LTrim"
+	// CacheServiceListKeysProcedure is the fully-qualified name of the CacheService's ListKeys RPC.
+	CacheServiceListKeysProcedure = "/cache.v1.CacheService/ListKeys"
+	// CacheServicePersistProcedure is the fully-qualified name of the CacheService's Persist RPC.
+	CacheServicePersistProcedure = "/cache.v1.CacheService/Persist"
 	// CacheServicePurgeProcedure is the fully-qualified name of the CacheService's Purge RPC.
 	CacheServicePurgeProcedure = "/cache.v1.CacheService/Purge"
+	// CacheServicePutObjectProcedure is the fully-qualified name of the CacheService's PutObject RPC.
+	CacheServicePutObjectProcedure = "/cache.v1.CacheService/PutObject"
+	// CacheServiceRPopProcedure is the fully-qualified name of the CacheService's RPop RPC.
+	CacheServiceRPopProcedure = "/cache.v1.CacheService/RPop"
+	// CacheServiceRPushProcedure is the fully-qualified name of the CacheService's RPush RPC.
+	CacheServiceRPushProcedure = "/cache.v1.CacheService/RPush"
+	// CacheServiceRateLimitProcedure is the fully-qualified name of the CacheService's RateLimit RPC.
+	CacheServiceRateLimitProcedure = "/cache.v1.CacheService/RateLimit"
+	// CacheServiceRevertProcedure is the fully-qualified name of the CacheService's Revert RPC.
+	CacheServiceRevertProcedure = "/cache.v1.CacheService/Revert"
+	// CacheServiceSAddProcedure is the fully-qualified name of the CacheService's SAdd RPC.
+	CacheServiceSAddProcedure = "/cache.v1.CacheService/SAdd"
+	// CacheServiceSCardProcedure is the fully-qualified name of the CacheService's SCard RPC.
+	CacheServiceSCardProcedure = "/cache.v1.CacheService/SCard"
+	// CacheServiceSIsMemberProcedure is the fully-qualified name of the CacheService's SIsMember RPC.
+	CacheServiceSIsMemberProcedure = "/cache.v1.CacheService/SIsMember"
+	// CacheServiceSMembersProcedure is the fully-qualified name of the CacheService's SMembers RPC.
+	CacheServiceSMembersProcedure = "/cache.v1.CacheService/SMembers"
+	// CacheServiceSRemProcedure is the fully-qualified name of the CacheService's SRem RPC.
+	CacheServiceSRemProcedure = "/cache.v1.CacheService/SRem"
 	// CacheServiceSetStreamProcedure is the fully-qualified name of the CacheService's SetStream RPC.
 	CacheServiceSetStreamProcedure = "/cache.v1.CacheService/SetStream"
 	// CacheServiceSetProcedure is the fully-qualified name of the CacheService's Set RPC.
 	CacheServiceSetProcedure = "/cache.v1.CacheService/Set"
+	// CacheServiceSetMultiProcedure is the fully-qualified name of the CacheService's SetMulti RPC.
+	CacheServiceSetMultiProcedure = "/cache.v1.CacheService/SetMulti"
+	// CacheServiceTouchProcedure is the fully-qualified name of the CacheService's Touch RPC.
+	CacheServiceTouchProcedure = "/cache.v1.CacheService/Touch"
+	// CacheServiceTTLProcedure is the fully-qualified name of the CacheService's TTL RPC.
+	CacheServiceTTLProcedure = "/cache.v1.CacheService/TTL"
+	// CacheServiceWatchProcedure is the fully-qualified name of the CacheService's Watch RPC.
+	CacheServiceWatchProcedure = "/cache.v1.CacheService/Watch"
+	// CacheServiceZAddProcedure is the fully-qualified name of the CacheService's ZAdd RPC.
+	CacheServiceZAddProcedure = "/cache.v1.CacheService/ZAdd"
+	// CacheServiceZIncrByProcedure is the fully-qualified name of the CacheService's ZIncrBy RPC.
+	CacheServiceZIncrByProcedure = "/cache.v1.CacheService/ZIncrBy"
+	// CacheServiceZRangeProcedure is the fully-qualified name of the CacheService's ZRange RPC.
+	CacheServiceZRangeProcedure = "/cache.v1.CacheService/ZRange"
+	// CacheServiceZRankProcedure is the fully-qualified name of the CacheService's ZRank RPC.
+	CacheServiceZRankProcedure = "/cache.v1.CacheService/ZRank"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	cacheServiceServiceDescriptor         = v1.File_cache_v1_cache_proto.Services().ByName("CacheService")
-	cacheServiceDeleteMethodDescriptor    = cacheServiceServiceDescriptor.Methods().ByName("Delete")
-	cacheServiceExistsMethodDescriptor    = cacheServiceServiceDescriptor.Methods().ByName("Exists")
-	cacheServiceGetMethodDescriptor       = cacheServiceServiceDescriptor.Methods().ByName("Get")
-	cacheServiceGetMultiMethodDescriptor  = cacheServiceServiceDescriptor.Methods().ByName("GetMulti")
-	cacheServiceGetStreamMethodDescriptor = cacheServiceServiceDescriptor.Methods().ByName("GetStream")
-	cacheServicePurgeMethodDescriptor     = cacheServiceServiceDescriptor.Methods().ByName("Purge")
-	cacheServiceSetStreamMethodDescriptor = cacheServiceServiceDescriptor.Methods().ByName("SetStream")
-	cacheServiceSetMethodDescriptor       = cacheServiceServiceDescriptor.Methods().ByName("Set")
+	lockServiceServiceDescriptor               = v1.File_cache_v1_cache_proto.Services().ByName("LockService")
+	lockServiceAcquireMethodDescriptor         = lockServiceServiceDescriptor.Methods().ByName("Acquire")
+	lockServiceReleaseMethodDescriptor         = lockServiceServiceDescriptor.Methods().ByName("Release")
+	lockServiceRenewMethodDescriptor           = lockServiceServiceDescriptor.Methods().ByName("Renew")
+	cacheServiceServiceDescriptor              = v1.File_cache_v1_cache_proto.Services().ByName("CacheService")
+	cacheServiceDecrementMethodDescriptor      = cacheServiceServiceDescriptor.Methods().ByName("Decrement")
+	cacheServiceDeleteMethodDescriptor         = cacheServiceServiceDescriptor.Methods().ByName("Delete")
+	cacheServiceDeleteMultiMethodDescriptor    = cacheServiceServiceDescriptor.Methods().ByName("DeleteMulti")
+	cacheServiceExistsMethodDescriptor         = cacheServiceServiceDescriptor.Methods().ByName("Exists")
+	cacheServiceExpireAtMethodDescriptor       = cacheServiceServiceDescriptor.Methods().ByName("ExpireAt")
+	cacheServiceFillMethodDescriptor           = cacheServiceServiceDescriptor.Methods().ByName("Fill")
+	cacheServiceGetMethodDescriptor            = cacheServiceServiceDescriptor.Methods().ByName("Get")
+	cacheServiceGetAndDeleteMethodDescriptor   = cacheServiceServiceDescriptor.Methods().ByName("GetAndDelete")
+	cacheServiceGetAndSetMethodDescriptor      = cacheServiceServiceDescriptor.Methods().ByName("GetAndSet")
+	cacheServiceGetMetadataMethodDescriptor    = cacheServiceServiceDescriptor.Methods().ByName("GetMetadata")
+	cacheServiceGetMultiMethodDescriptor       = cacheServiceServiceDescriptor.Methods().ByName("GetMulti")
+	cacheServiceGetObjectMethodDescriptor      = cacheServiceServiceDescriptor.Methods().ByName("GetObject")
+	cacheServiceGetOrLeaseMethodDescriptor     = cacheServiceServiceDescriptor.Methods().ByName("GetOrLease")
+	cacheServiceGetStreamMethodDescriptor      = cacheServiceServiceDescriptor.Methods().ByName("GetStream")
+	cacheServiceHDelMethodDescriptor           = cacheServiceServiceDescriptor.Methods().ByName("HDel")
+	cacheServiceHGetMethodDescriptor           = cacheServiceServiceDescriptor.Methods().ByName("HGet")
+	cacheServiceHGetAllMethodDescriptor        = cacheServiceServiceDescriptor.Methods().ByName("HGetAll")
+	cacheServiceHIncrByMethodDescriptor        = cacheServiceServiceDescriptor.Methods().ByName("HIncrBy")
+	cacheServiceHMGetMethodDescriptor          = cacheServiceServiceDescriptor.Methods().ByName("HMGet")
+	cacheServiceHSetMethodDescriptor           = cacheServiceServiceDescriptor.Methods().ByName("HSet")
+	cacheServiceHistoryMethodDescriptor        = cacheServiceServiceDescriptor.Methods().ByName("History")
+	cacheServiceIncrementMethodDescriptor      = cacheServiceServiceDescriptor.Methods().ByName("Increment")
+	cacheServiceInvalidateTagsMethodDescriptor = cacheServiceServiceDescriptor.Methods().ByName("InvalidateTags")
+	cacheServiceLLenMethodDescriptor           = cacheServiceServiceDescriptor.Methods().ByName("LLen")
+	cacheServiceLPopMethodDescriptor           = cacheServiceServiceDescriptor.Methods().ByName("LPop")
+	cacheServiceLPushMethodDescriptor          = cacheServiceServiceDescriptor.Methods().ByName("LPush")
+	cacheServiceLRangeMethodDescriptor         = cacheServiceServiceDescriptor.Methods().ByName("LRange")
+	cacheServiceLTrimMethodDescriptor          = cacheServiceServiceDescriptor.Methods().ByName("LTrim")
+	cacheServiceListKeysMethodDescriptor       = cacheServiceServiceDescriptor.Methods().ByName("ListKeys")
+	cacheServicePersistMethodDescriptor        = cacheServiceServiceDescriptor.Methods().ByName("Persist")
+	cacheServicePurgeMethodDescriptor          = cacheServiceServiceDescriptor.Methods().ByName("Purge")
+	cacheServicePutObjectMethodDescriptor      = cacheServiceServiceDescriptor.Methods().ByName("PutObject")
+	cacheServiceRPopMethodDescriptor           = cacheServiceServiceDescriptor.Methods().ByName("RPop")
+	cacheServiceRPushMethodDescriptor          = cacheServiceServiceDescriptor.Methods().ByName("RPush")
+	cacheServiceRateLimitMethodDescriptor      = cacheServiceServiceDescriptor.Methods().ByName("RateLimit")
+	cacheServiceRevertMethodDescriptor         = cacheServiceServiceDescriptor.Methods().ByName("Revert")
+	cacheServiceSAddMethodDescriptor           = cacheServiceServiceDescriptor.Methods().ByName("SAdd")
+	cacheServiceSCardMethodDescriptor          = cacheServiceServiceDescriptor.Methods().ByName("SCard")
+	cacheServiceSIsMemberMethodDescriptor      = cacheServiceServiceDescriptor.Methods().ByName("SIsMember")
+	cacheServiceSMembersMethodDescriptor       = cacheServiceServiceDescriptor.Methods().ByName("SMembers")
+	cacheServiceSRemMethodDescriptor           = cacheServiceServiceDescriptor.Methods().ByName("SRem")
+	cacheServiceSetStreamMethodDescriptor      = cacheServiceServiceDescriptor.Methods().ByName("SetStream")
+	cacheServiceSetMethodDescriptor            = cacheServiceServiceDescriptor.Methods().ByName("Set")
+	cacheServiceSetMultiMethodDescriptor       = cacheServiceServiceDescriptor.Methods().ByName("SetMulti")
+	cacheServiceTouchMethodDescriptor          = cacheServiceServiceDescriptor.Methods().ByName("Touch")
+	cacheServiceTTLMethodDescriptor            = cacheServiceServiceDescriptor.Methods().ByName("TTL")
+	cacheServiceWatchMethodDescriptor          = cacheServiceServiceDescriptor.Methods().ByName("Watch")
+	cacheServiceZAddMethodDescriptor           = cacheServiceServiceDescriptor.Methods().ByName("ZAdd")
+	cacheServiceZIncrByMethodDescriptor        = cacheServiceServiceDescriptor.Methods().ByName("ZIncrBy")
+	cacheServiceZRangeMethodDescriptor         = cacheServiceServiceDescriptor.Methods().ByName("ZRange")
+	cacheServiceZRankMethodDescriptor          = cacheServiceServiceDescriptor.Methods().ByName("ZRank")
 )
 
+// LockServiceClient is a client for the cache.v1.LockService service.
+type LockServiceClient interface {
+	Acquire(context.Context, *connect.Request[v1.AcquireRequest]) (*connect.Response[v1.AcquireResponse], error)
+	Release(context.Context, *connect.Request[v1.ReleaseRequest]) (*connect.Response[v1.ReleaseResponse], error)
+	Renew(context.Context, *connect.Request[v1.RenewRequest]) (*connect.Response[v1.RenewResponse], error)
+}
+
+// NewLockServiceClient constructs a client for the cache.v1.LockService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewLockServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) LockServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &lockServiceClient{
+		acquire: connect.NewClient[v1.AcquireRequest, v1.AcquireResponse](
+			httpClient,
+			baseURL+LockServiceAcquireProcedure,
+			connect.WithSchema(lockServiceAcquireMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		release: connect.NewClient[v1.ReleaseRequest, v1.ReleaseResponse](
+			httpClient,
+			baseURL+LockServiceReleaseProcedure,
+			connect.WithSchema(lockServiceReleaseMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		renew: connect.NewClient[v1.RenewRequest, v1.RenewResponse](
+			httpClient,
+			baseURL+LockServiceRenewProcedure,
+			connect.WithSchema(lockServiceRenewMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// lockServiceClient implements LockServiceClient.
+type lockServiceClient struct {
+	acquire *connect.Client[v1.AcquireRequest, v1.AcquireResponse]
+	release *connect.Client[v1.ReleaseRequest, v1.ReleaseResponse]
+	renew   *connect.Client[v1.RenewRequest, v1.RenewResponse]
+}
+
+// Acquire calls cache.v1.LockService.Acquire.
+func (c *lockServiceClient) Acquire(ctx context.Context, req *connect.Request[v1.AcquireRequest]) (*connect.Response[v1.AcquireResponse], error) {
+	return c.acquire.CallUnary(ctx, req)
+}
+
+// Release calls cache.v1.LockService.Release.
+func (c *lockServiceClient) Release(ctx context.Context, req *connect.Request[v1.ReleaseRequest]) (*connect.Response[v1.ReleaseResponse], error) {
+	return c.release.CallUnary(ctx, req)
+}
+
+// Renew calls cache.v1.LockService.Renew.
+func (c *lockServiceClient) Renew(ctx context.Context, req *connect.Request[v1.RenewRequest]) (*connect.Response[v1.RenewResponse], error) {
+	return c.renew.CallUnary(ctx, req)
+}
+
+// LockServiceHandler is an implementation of the cache.v1.LockService service.
+type LockServiceHandler interface {
+	Acquire(context.Context, *connect.Request[v1.AcquireRequest]) (*connect.Response[v1.AcquireResponse], error)
+	Release(context.Context, *connect.Request[v1.ReleaseRequest]) (*connect.Response[v1.ReleaseResponse], error)
+	Renew(context.Context, *connect.Request[v1.RenewRequest]) (*connect.Response[v1.RenewResponse], error)
+}
+
+// NewLockServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewLockServiceHandler(svc LockServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	lockServiceAcquireHandler := connect.NewUnaryHandler(
+		LockServiceAcquireProcedure,
+		svc.Acquire,
+		connect.WithSchema(lockServiceAcquireMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	lockServiceReleaseHandler := connect.NewUnaryHandler(
+		LockServiceReleaseProcedure,
+		svc.Release,
+		connect.WithSchema(lockServiceReleaseMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	lockServiceRenewHandler := connect.NewUnaryHandler(
+		LockServiceRenewProcedure,
+		svc.Renew,
+		connect.WithSchema(lockServiceRenewMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/cache.v1.LockService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case LockServiceAcquireProcedure:
+			lockServiceAcquireHandler.ServeHTTP(w, r)
+		case LockServiceReleaseProcedure:
+			lockServiceReleaseHandler.ServeHTTP(w, r)
+		case LockServiceRenewProcedure:
+			lockServiceRenewHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedLockServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedLockServiceHandler struct{}
+
+func (UnimplementedLockServiceHandler) Acquire(context.Context, *connect.Request[v1.AcquireRequest]) (*connect.Response[v1.AcquireResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.LockService.Acquire is not implemented"))
+}
+
+func (UnimplementedLockServiceHandler) Release(context.Context, *connect.Request[v1.ReleaseRequest]) (*connect.Response[v1.ReleaseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.LockService.Release is not implemented"))
+}
+
+func (UnimplementedLockServiceHandler) Renew(context.Context, *connect.Request[v1.RenewRequest]) (*connect.Response[v1.RenewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.LockService.Renew is not implemented"))
+}
+
 // CacheServiceClient is a client for the cache.v1.CacheService service.
 type CacheServiceClient interface {
+	Decrement(context.Context, *connect.Request[v1.DecrementRequest]) (*connect.Response[v1.DecrementResponse], error)
 	Delete(context.Context, *connect.Request[v1.DeleteRequest]) (*connect.Response[v1.DeleteResponse], error)
+	DeleteMulti(context.Context, *connect.Request[v1.DeleteMultiRequest]) (*connect.Response[v1.DeleteMultiResponse], error)
 	Exists(context.Context, *connect.Request[v1.ExistsRequest]) (*connect.Response[v1.ExistsResponse], error)
+	ExpireAt(context.Context, *connect.Request[v1.ExpireAtRequest]) (*connect.Response[v1.ExpireAtResponse], error)
+	Fill(context.Context, *connect.Request[v1.FillRequest]) (*connect.Response[v1.FillResponse], error)
 	Get(context.Context, *connect.Request[v1.GetRequest]) (*connect.Response[v1.GetResponse], error)
+	GetAndDelete(context.Context, *connect.Request[v1.GetAndDeleteRequest]) (*connect.Response[v1.GetAndDeleteResponse], error)
+	GetAndSet(context.Context, *connect.Request[v1.GetAndSetRequest]) (*connect.Response[v1.GetAndSetResponse], error)
+	GetMetadata(context.Context, *connect.Request[v1.GetMetadataRequest]) (*connect.Response[v1.GetMetadataResponse], error)
 	GetMulti(context.Context, *connect.Request[v1.GetMultiRequest]) (*connect.Response[v1.GetMultiResponse], error)
+	GetObject(context.Context, *connect.Request[v1.GetObjectRequest]) (*connect.ServerStreamForClient[v1.GetObjectResponse], error)
+	GetOrLease(context.Context, *connect.Request[v1.GetOrLeaseRequest]) (*connect.Response[v1.GetOrLeaseResponse], error)
 	GetStream(context.Context) *connect.BidiStreamForClient[v1.GetRequest, v1.GetResponse]
+	HDel(context.Context, *connect.Request[v1.HDelRequest]) (*connect.Response[v1.HDelResponse], error)
+	HGet(context.Context, *connect.Request[v1.HGetRequest]) (*connect.Response[v1.HGetResponse], error)
+	HGetAll(context.Context, *connect.Request[v1.HGetAllRequest]) (*connect.Response[v1.HGetAllResponse], error)
+	HIncrBy(context.Context, *connect.Request[v1.HIncrByRequest]) (*connect.Response[v1.HIncrByResponse], error)
+	HMGet(context.Context, *connect.Request[v1.HMGetRequest]) (*connect.Response[v1.HMGetResponse], error)
+	HSet(context.Context, *connect.Request[v1.HSetRequest]) (*connect.Response[v1.HSetResponse], error)
+	History(context.Context, *connect.Request[v1.HistoryRequest]) (*connect.Response[v1.HistoryResponse], error)
+	Increment(context.Context, *connect.Request[v1.IncrementRequest]) (*connect.Response[v1.IncrementResponse], error)
+	InvalidateTags(context.Context, *connect.Request[v1.InvalidateTagsRequest]) (*connect.Response[v1.InvalidateTagsResponse], error)
+	LLen(context.Context, *connect.Request[v1.LLenRequest]) (*connect.Response[v1.LLenResponse], error)
+	LPop(context.Context, *connect.Request[v1.LPopRequest]) (*connect.Response[v1.LPopResponse], error)
+	LPush(context.Context, *connect.Request[v1.LPushRequest]) (*connect.Response[v1.LPushResponse], error)
+	LRange(context.Context, *connect.Request[v1.LRangeRequest]) (*connect.Response[v1.LRangeResponse], error)
+	LTrim(context.Context, *connect.Request[v1.LTrimRequest]) (*connect.Response[v1.LTrimResponse], error)
+	ListKeys(context.Context, *connect.Request[v1.ListKeysRequest]) (*connect.Response[v1.ListKeysResponse], error)
+	Persist(context.Context, *connect.Request[v1.PersistRequest]) (*connect.Response[v1.PersistResponse], error)
 	Purge(context.Context, *connect.Request[v1.PurgeRequest]) (*connect.Response[v1.PurgeResponse], error)
+	PutObject(context.Context) *connect.ClientStreamForClient[v1.PutObjectRequest, v1.PutObjectResponse]
+	RPop(context.Context, *connect.Request[v1.RPopRequest]) (*connect.Response[v1.RPopResponse], error)
+	RPush(context.Context, *connect.Request[v1.RPushRequest]) (*connect.Response[v1.RPushResponse], error)
+	RateLimit(context.Context, *connect.Request[v1.RateLimitRequest]) (*connect.Response[v1.RateLimitResponse], error)
+	Revert(context.Context, *connect.Request[v1.RevertRequest]) (*connect.Response[v1.RevertResponse], error)
+	SAdd(context.Context, *connect.Request[v1.SAddRequest]) (*connect.Response[v1.SAddResponse], error)
+	SCard(context.Context, *connect.Request[v1.SCardRequest]) (*connect.Response[v1.SCardResponse], error)
+	SIsMember(context.Context, *connect.Request[v1.SIsMemberRequest]) (*connect.Response[v1.SIsMemberResponse], error)
+	SMembers(context.Context, *connect.Request[v1.SMembersRequest]) (*connect.Response[v1.SMembersResponse], error)
+	SRem(context.Context, *connect.Request[v1.SRemRequest]) (*connect.Response[v1.SRemResponse], error)
 	SetStream(context.Context) *connect.BidiStreamForClient[v1.SetRequest, v1.SetResponse]
 	Set(context.Context, *connect.Request[v1.SetRequest]) (*connect.Response[v1.SetResponse], error)
+	SetMulti(context.Context, *connect.Request[v1.SetMultiRequest]) (*connect.Response[v1.SetMultiResponse], error)
+	Touch(context.Context, *connect.Request[v1.TouchRequest]) (*connect.Response[v1.TouchResponse], error)
+	TTL(context.Context, *connect.Request[v1.TTLRequest]) (*connect.Response[v1.TTLResponse], error)
+	Watch(context.Context, *connect.Request[v1.WatchRequest]) (*connect.ServerStreamForClient[v1.WatchResponse], error)
+	ZAdd(context.Context, *connect.Request[v1.ZAddRequest]) (*connect.Response[v1.ZAddResponse], error)
+	ZIncrBy(context.Context, *connect.Request[v1.ZIncrByRequest]) (*connect.Response[v1.ZIncrByResponse], error)
+	ZRange(context.Context, *connect.Request[v1.ZRangeRequest]) (*connect.Response[v1.ZRangeResponse], error)
+	ZRank(context.Context, *connect.Request[v1.ZRankRequest]) (*connect.Response[v1.ZRankResponse], error)
 }
 
 // NewCacheServiceClient constructs a client for the cache.v1.CacheService service. By default, it
@@ -86,42 +394,252 @@ type CacheServiceClient interface {
 func NewCacheServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CacheServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &cacheServiceClient{
+		decrement: connect.NewClient[v1.DecrementRequest, v1.DecrementResponse](
+			httpClient,
+			baseURL+CacheServiceDecrementProcedure,
+			connect.WithSchema(cacheServiceDecrementMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		delete: connect.NewClient[v1.DeleteRequest, v1.DeleteResponse](
 			httpClient,
 			baseURL+CacheServiceDeleteProcedure,
 			connect.WithSchema(cacheServiceDeleteMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		deleteMulti: connect.NewClient[v1.DeleteMultiRequest, v1.DeleteMultiResponse](
+			httpClient,
+			baseURL+CacheServiceDeleteMultiProcedure,
+			connect.WithSchema(cacheServiceDeleteMultiMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		exists: connect.NewClient[v1.ExistsRequest, v1.ExistsResponse](
 			httpClient,
 			baseURL+CacheServiceExistsProcedure,
 			connect.WithSchema(cacheServiceExistsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		expireAt: connect.NewClient[v1.ExpireAtRequest, v1.ExpireAtResponse](
+			httpClient,
+			baseURL+CacheServiceExpireAtProcedure,
+			connect.WithSchema(cacheServiceExpireAtMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		fill: connect.NewClient[v1.FillRequest, v1.FillResponse](
+			httpClient,
+			baseURL+CacheServiceFillProcedure,
+			connect.WithSchema(cacheServiceFillMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		get: connect.NewClient[v1.GetRequest, v1.GetResponse](
 			httpClient,
 			baseURL+CacheServiceGetProcedure,
 			connect.WithSchema(cacheServiceGetMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getAndDelete: connect.NewClient[v1.GetAndDeleteRequest, v1.GetAndDeleteResponse](
+			httpClient,
+			baseURL+CacheServiceGetAndDeleteProcedure,
+			connect.WithSchema(cacheServiceGetAndDeleteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getAndSet: connect.NewClient[v1.GetAndSetRequest, v1.GetAndSetResponse](
+			httpClient,
+			baseURL+CacheServiceGetAndSetProcedure,
+			connect.WithSchema(cacheServiceGetAndSetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getMetadata: connect.NewClient[v1.GetMetadataRequest, v1.GetMetadataResponse](
+			httpClient,
+			baseURL+CacheServiceGetMetadataProcedure,
+			connect.WithSchema(cacheServiceGetMetadataMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getMulti: connect.NewClient[v1.GetMultiRequest, v1.GetMultiResponse](
 			httpClient,
 			baseURL+CacheServiceGetMultiProcedure,
 			connect.WithSchema(cacheServiceGetMultiMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getObject: connect.NewClient[v1.GetObjectRequest, v1.GetObjectResponse](
+			httpClient,
+			baseURL+CacheServiceGetObjectProcedure,
+			connect.WithSchema(cacheServiceGetObjectMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getOrLease: connect.NewClient[v1.GetOrLeaseRequest, v1.GetOrLeaseResponse](
+			httpClient,
+			baseURL+CacheServiceGetOrLeaseProcedure,
+			connect.WithSchema(cacheServiceGetOrLeaseMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getStream: connect.NewClient[v1.GetRequest, v1.GetResponse](
 			httpClient,
 			baseURL+CacheServiceGetStreamProcedure,
 			connect.WithSchema(cacheServiceGetStreamMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		hDel: connect.NewClient[v1.HDelRequest, v1.HDelResponse](
+			httpClient,
+			baseURL+CacheServiceHDelProcedure,
+			connect.WithSchema(cacheServiceHDelMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		hGet: connect.NewClient[v1.HGetRequest, v1.HGetResponse](
+			httpClient,
+			baseURL+CacheServiceHGetProcedure,
+			connect.WithSchema(cacheServiceHGetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		hGetAll: connect.NewClient[v1.HGetAllRequest, v1.HGetAllResponse](
+			httpClient,
+			baseURL+CacheServiceHGetAllProcedure,
+			connect.WithSchema(cacheServiceHGetAllMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		hIncrBy: connect.NewClient[v1.HIncrByRequest, v1.HIncrByResponse](
+			httpClient,
+			baseURL+CacheServiceHIncrByProcedure,
+			connect.WithSchema(cacheServiceHIncrByMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		hMGet: connect.NewClient[v1.HMGetRequest, v1.HMGetResponse](
+			httpClient,
+			baseURL+CacheServiceHMGetProcedure,
+			connect.WithSchema(cacheServiceHMGetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		hSet: connect.NewClient[v1.HSetRequest, v1.HSetResponse](
+			httpClient,
+			baseURL+CacheServiceHSetProcedure,
+			connect.WithSchema(cacheServiceHSetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		history: connect.NewClient[v1.HistoryRequest, v1.HistoryResponse](
+			httpClient,
+			baseURL+CacheServiceHistoryProcedure,
+			connect.WithSchema(cacheServiceHistoryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		increment: connect.NewClient[v1.IncrementRequest, v1.IncrementResponse](
+			httpClient,
+			baseURL+CacheServiceIncrementProcedure,
+			connect.WithSchema(cacheServiceIncrementMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		invalidateTags: connect.NewClient[v1.InvalidateTagsRequest, v1.InvalidateTagsResponse](
+			httpClient,
+			baseURL+CacheServiceInvalidateTagsProcedure,
+			connect.WithSchema(cacheServiceInvalidateTagsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		lLen: connect.NewClient[v1.LLenRequest, v1.LLenResponse](
+			httpClient,
+			baseURL+CacheServiceLLenProcedure,
+			connect.WithSchema(cacheServiceLLenMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		lPop: connect.NewClient[v1.LPopRequest, v1.LPopResponse](
+			httpClient,
+			baseURL+CacheServiceLPopProcedure,
+			connect.WithSchema(cacheServiceLPopMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		lPush: connect.NewClient[v1.LPushRequest, v1.LPushResponse](
+			httpClient,
+			baseURL+CacheServiceLPushProcedure,
+			connect.WithSchema(cacheServiceLPushMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		lRange: connect.NewClient[v1.LRangeRequest, v1.LRangeResponse](
+			httpClient,
+			baseURL+CacheServiceLRangeProcedure,
+			connect.WithSchema(cacheServiceLRangeMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		lTrim: connect.NewClient[v1.LTrimRequest, v1.LTrimResponse](
+			httpClient,
+			baseURL+CacheServiceLTrimProcedure,
+			connect.WithSchema(cacheServiceLTrimMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listKeys: connect.NewClient[v1.ListKeysRequest, v1.ListKeysResponse](
+			httpClient,
+			baseURL+CacheServiceListKeysProcedure,
+			connect.WithSchema(cacheServiceListKeysMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		persist: connect.NewClient[v1.PersistRequest, v1.PersistResponse](
+			httpClient,
+			baseURL+CacheServicePersistProcedure,
+			connect.WithSchema(cacheServicePersistMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		purge: connect.NewClient[v1.PurgeRequest, v1.PurgeResponse](
 			httpClient,
 			baseURL+CacheServicePurgeProcedure,
 			connect.WithSchema(cacheServicePurgeMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		putObject: connect.NewClient[v1.PutObjectRequest, v1.PutObjectResponse](
+			httpClient,
+			baseURL+CacheServicePutObjectProcedure,
+			connect.WithSchema(cacheServicePutObjectMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		rPop: connect.NewClient[v1.RPopRequest, v1.RPopResponse](
+			httpClient,
+			baseURL+CacheServiceRPopProcedure,
+			connect.WithSchema(cacheServiceRPopMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		rPush: connect.NewClient[v1.RPushRequest, v1.RPushResponse](
+			httpClient,
+			baseURL+CacheServiceRPushProcedure,
+			connect.WithSchema(cacheServiceRPushMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		rateLimit: connect.NewClient[v1.RateLimitRequest, v1.RateLimitResponse](
+			httpClient,
+			baseURL+CacheServiceRateLimitProcedure,
+			connect.WithSchema(cacheServiceRateLimitMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		revert: connect.NewClient[v1.RevertRequest, v1.RevertResponse](
+			httpClient,
+			baseURL+CacheServiceRevertProcedure,
+			connect.WithSchema(cacheServiceRevertMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		sAdd: connect.NewClient[v1.SAddRequest, v1.SAddResponse](
+			httpClient,
+			baseURL+CacheServiceSAddProcedure,
+			connect.WithSchema(cacheServiceSAddMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		sCard: connect.NewClient[v1.SCardRequest, v1.SCardResponse](
+			httpClient,
+			baseURL+CacheServiceSCardProcedure,
+			connect.WithSchema(cacheServiceSCardMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		sIsMember: connect.NewClient[v1.SIsMemberRequest, v1.SIsMemberResponse](
+			httpClient,
+			baseURL+CacheServiceSIsMemberProcedure,
+			connect.WithSchema(cacheServiceSIsMemberMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		sMembers: connect.NewClient[v1.SMembersRequest, v1.SMembersResponse](
+			httpClient,
+			baseURL+CacheServiceSMembersProcedure,
+			connect.WithSchema(cacheServiceSMembersMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		sRem: connect.NewClient[v1.SRemRequest, v1.SRemResponse](
+			httpClient,
+			baseURL+CacheServiceSRemProcedure,
+			connect.WithSchema(cacheServiceSRemMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		setStream: connect.NewClient[v1.SetRequest, v1.SetResponse](
 			httpClient,
 			baseURL+CacheServiceSetStreamProcedure,
@@ -134,19 +652,115 @@ func NewCacheServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(cacheServiceSetMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		setMulti: connect.NewClient[v1.SetMultiRequest, v1.SetMultiResponse](
+			httpClient,
+			baseURL+CacheServiceSetMultiProcedure,
+			connect.WithSchema(cacheServiceSetMultiMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		touch: connect.NewClient[v1.TouchRequest, v1.TouchResponse](
+			httpClient,
+			baseURL+CacheServiceTouchProcedure,
+			connect.WithSchema(cacheServiceTouchMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		tTL: connect.NewClient[v1.TTLRequest, v1.TTLResponse](
+			httpClient,
+			baseURL+CacheServiceTTLProcedure,
+			connect.WithSchema(cacheServiceTTLMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		watch: connect.NewClient[v1.WatchRequest, v1.WatchResponse](
+			httpClient,
+			baseURL+CacheServiceWatchProcedure,
+			connect.WithSchema(cacheServiceWatchMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		zAdd: connect.NewClient[v1.ZAddRequest, v1.ZAddResponse](
+			httpClient,
+			baseURL+CacheServiceZAddProcedure,
+			connect.WithSchema(cacheServiceZAddMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		zIncrBy: connect.NewClient[v1.ZIncrByRequest, v1.ZIncrByResponse](
+			httpClient,
+			baseURL+CacheServiceZIncrByProcedure,
+			connect.WithSchema(cacheServiceZIncrByMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		zRange: connect.NewClient[v1.ZRangeRequest, v1.ZRangeResponse](
+			httpClient,
+			baseURL+CacheServiceZRangeProcedure,
+			connect.WithSchema(cacheServiceZRangeMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		zRank: connect.NewClient[v1.ZRankRequest, v1.ZRankResponse](
+			httpClient,
+			baseURL+CacheServiceZRankProcedure,
+			connect.WithSchema(cacheServiceZRankMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // cacheServiceClient implements CacheServiceClient.
 type cacheServiceClient struct {
-	delete    *connect.Client[v1.DeleteRequest, v1.DeleteResponse]
-	exists    *connect.Client[v1.ExistsRequest, v1.ExistsResponse]
-	get       *connect.Client[v1.GetRequest, v1.GetResponse]
-	getMulti  *connect.Client[v1.GetMultiRequest, v1.GetMultiResponse]
-	getStream *connect.Client[v1.GetRequest, v1.GetResponse]
-	purge     *connect.Client[v1.PurgeRequest, v1.PurgeResponse]
-	setStream *connect.Client[v1.SetRequest, v1.SetResponse]
-	set       *connect.Client[v1.SetRequest, v1.SetResponse]
+	decrement      *connect.Client[v1.DecrementRequest, v1.DecrementResponse]
+	delete         *connect.Client[v1.DeleteRequest, v1.DeleteResponse]
+	deleteMulti    *connect.Client[v1.DeleteMultiRequest, v1.DeleteMultiResponse]
+	exists         *connect.Client[v1.ExistsRequest, v1.ExistsResponse]
+	expireAt       *connect.Client[v1.ExpireAtRequest, v1.ExpireAtResponse]
+	fill           *connect.Client[v1.FillRequest, v1.FillResponse]
+	get            *connect.Client[v1.GetRequest, v1.GetResponse]
+	getAndDelete   *connect.Client[v1.GetAndDeleteRequest, v1.GetAndDeleteResponse]
+	getAndSet      *connect.Client[v1.GetAndSetRequest, v1.GetAndSetResponse]
+	getMetadata    *connect.Client[v1.GetMetadataRequest, v1.GetMetadataResponse]
+	getMulti       *connect.Client[v1.GetMultiRequest, v1.GetMultiResponse]
+	getObject      *connect.Client[v1.GetObjectRequest, v1.GetObjectResponse]
+	getOrLease     *connect.Client[v1.GetOrLeaseRequest, v1.GetOrLeaseResponse]
+	getStream      *connect.Client[v1.GetRequest, v1.GetResponse]
+	hDel           *connect.Client[v1.HDelRequest, v1.HDelResponse]
+	hGet           *connect.Client[v1.HGetRequest, v1.HGetResponse]
+	hGetAll        *connect.Client[v1.HGetAllRequest, v1.HGetAllResponse]
+	hIncrBy        *connect.Client[v1.HIncrByRequest, v1.HIncrByResponse]
+	hMGet          *connect.Client[v1.HMGetRequest, v1.HMGetResponse]
+	hSet           *connect.Client[v1.HSetRequest, v1.HSetResponse]
+	history        *connect.Client[v1.HistoryRequest, v1.HistoryResponse]
+	increment      *connect.Client[v1.IncrementRequest, v1.IncrementResponse]
+	invalidateTags *connect.Client[v1.InvalidateTagsRequest, v1.InvalidateTagsResponse]
+	lLen           *connect.Client[v1.LLenRequest, v1.LLenResponse]
+	lPop           *connect.Client[v1.LPopRequest, v1.LPopResponse]
+	lPush          *connect.Client[v1.LPushRequest, v1.LPushResponse]
+	lRange         *connect.Client[v1.LRangeRequest, v1.LRangeResponse]
+	lTrim          *connect.Client[v1.LTrimRequest, v1.LTrimResponse]
+	listKeys       *connect.Client[v1.ListKeysRequest, v1.ListKeysResponse]
+	persist        *connect.Client[v1.PersistRequest, v1.PersistResponse]
+	purge          *connect.Client[v1.PurgeRequest, v1.PurgeResponse]
+	putObject      *connect.Client[v1.PutObjectRequest, v1.PutObjectResponse]
+	rPop           *connect.Client[v1.RPopRequest, v1.RPopResponse]
+	rPush          *connect.Client[v1.RPushRequest, v1.RPushResponse]
+	rateLimit      *connect.Client[v1.RateLimitRequest, v1.RateLimitResponse]
+	revert         *connect.Client[v1.RevertRequest, v1.RevertResponse]
+	sAdd           *connect.Client[v1.SAddRequest, v1.SAddResponse]
+	sCard          *connect.Client[v1.SCardRequest, v1.SCardResponse]
+	sIsMember      *connect.Client[v1.SIsMemberRequest, v1.SIsMemberResponse]
+	sMembers       *connect.Client[v1.SMembersRequest, v1.SMembersResponse]
+	sRem           *connect.Client[v1.SRemRequest, v1.SRemResponse]
+	setStream      *connect.Client[v1.SetRequest, v1.SetResponse]
+	set            *connect.Client[v1.SetRequest, v1.SetResponse]
+	setMulti       *connect.Client[v1.SetMultiRequest, v1.SetMultiResponse]
+	touch          *connect.Client[v1.TouchRequest, v1.TouchResponse]
+	tTL            *connect.Client[v1.TTLRequest, v1.TTLResponse]
+	watch          *connect.Client[v1.WatchRequest, v1.WatchResponse]
+	zAdd           *connect.Client[v1.ZAddRequest, v1.ZAddResponse]
+	zIncrBy        *connect.Client[v1.ZIncrByRequest, v1.ZIncrByResponse]
+	zRange         *connect.Client[v1.ZRangeRequest, v1.ZRangeResponse]
+	zRank          *connect.Client[v1.ZRankRequest, v1.ZRankResponse]
+}
+
+// Decrement calls cache.v1.CacheService.Decrement.
+func (c *cacheServiceClient) Decrement(ctx context.Context, req *connect.Request[v1.DecrementRequest]) (*connect.Response[v1.DecrementResponse], error) {
+	return c.decrement.CallUnary(ctx, req)
 }
 
 // Delete calls cache.v1.CacheService.Delete.
@@ -154,31 +768,201 @@ func (c *cacheServiceClient) Delete(ctx context.Context, req *connect.Request[v1
 	return c.delete.CallUnary(ctx, req)
 }
 
+// DeleteMulti calls cache.v1.CacheService.DeleteMulti.
+func (c *cacheServiceClient) DeleteMulti(ctx context.Context, req *connect.Request[v1.DeleteMultiRequest]) (*connect.Response[v1.DeleteMultiResponse], error) {
+	return c.deleteMulti.CallUnary(ctx, req)
+}
+
 // Exists calls cache.v1.CacheService.Exists.
 func (c *cacheServiceClient) Exists(ctx context.Context, req *connect.Request[v1.ExistsRequest]) (*connect.Response[v1.ExistsResponse], error) {
 	return c.exists.CallUnary(ctx, req)
 }
 
+// ExpireAt calls cache.v1.CacheService.ExpireAt.
+func (c *cacheServiceClient) ExpireAt(ctx context.Context, req *connect.Request[v1.ExpireAtRequest]) (*connect.Response[v1.ExpireAtResponse], error) {
+	return c.expireAt.CallUnary(ctx, req)
+}
+
+// Fill calls cache.v1.CacheService.Fill.
+func (c *cacheServiceClient) Fill(ctx context.Context, req *connect.Request[v1.FillRequest]) (*connect.Response[v1.FillResponse], error) {
+	return c.fill.CallUnary(ctx, req)
+}
+
 // Get calls cache.v1.CacheService.Get.
 func (c *cacheServiceClient) Get(ctx context.Context, req *connect.Request[v1.GetRequest]) (*connect.Response[v1.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
+// GetAndDelete calls cache.v1.CacheService.GetAndDelete.
+func (c *cacheServiceClient) GetAndDelete(ctx context.Context, req *connect.Request[v1.GetAndDeleteRequest]) (*connect.Response[v1.GetAndDeleteResponse], error) {
+	return c.getAndDelete.CallUnary(ctx, req)
+}
+
+// GetAndSet calls cache.v1.CacheService.GetAndSet.
+func (c *cacheServiceClient) GetAndSet(ctx context.Context, req *connect.Request[v1.GetAndSetRequest]) (*connect.Response[v1.GetAndSetResponse], error) {
+	return c.getAndSet.CallUnary(ctx, req)
+}
+
+// GetMetadata calls cache.v1.CacheService.GetMetadata.
+func (c *cacheServiceClient) GetMetadata(ctx context.Context, req *connect.Request[v1.GetMetadataRequest]) (*connect.Response[v1.GetMetadataResponse], error) {
+	return c.getMetadata.CallUnary(ctx, req)
+}
+
 // GetMulti calls cache.v1.CacheService.GetMulti.
 func (c *cacheServiceClient) GetMulti(ctx context.Context, req *connect.Request[v1.GetMultiRequest]) (*connect.Response[v1.GetMultiResponse], error) {
 	return c.getMulti.CallUnary(ctx, req)
 }
 
+// GetObject calls cache.v1.CacheService.GetObject.
+func (c *cacheServiceClient) GetObject(ctx context.Context, req *connect.Request[v1.GetObjectRequest]) (*connect.ServerStreamForClient[v1.GetObjectResponse], error) {
+	return c.getObject.CallServerStream(ctx, req)
+}
+
+// GetOrLease calls cache.v1.CacheService.GetOrLease.
+func (c *cacheServiceClient) GetOrLease(ctx context.Context, req *connect.Request[v1.GetOrLeaseRequest]) (*connect.Response[v1.GetOrLeaseResponse], error) {
+	return c.getOrLease.CallUnary(ctx, req)
+}
+
 // GetStream calls cache.v1.CacheService.GetStream.
 func (c *cacheServiceClient) GetStream(ctx context.Context) *connect.BidiStreamForClient[v1.GetRequest, v1.GetResponse] {
 	return c.getStream.CallBidiStream(ctx)
 }
 
+// HDel calls cache.v1.CacheService.HDel.
+func (c *cacheServiceClient) HDel(ctx context.Context, req *connect.Request[v1.HDelRequest]) (*connect.Response[v1.HDelResponse], error) {
+	return c.hDel.CallUnary(ctx, req)
+}
+
+// HGet calls cache.v1.CacheService.HGet.
+func (c *cacheServiceClient) HGet(ctx context.Context, req *connect.Request[v1.HGetRequest]) (*connect.Response[v1.HGetResponse], error) {
+	return c.hGet.CallUnary(ctx, req)
+}
+
+// HGetAll calls cache.v1.CacheService.HGetAll.
+func (c *cacheServiceClient) HGetAll(ctx context.Context, req *connect.Request[v1.HGetAllRequest]) (*connect.Response[v1.HGetAllResponse], error) {
+	return c.hGetAll.CallUnary(ctx, req)
+}
+
+// HIncrBy calls cache.v1.CacheService.HIncrBy.
+func (c *cacheServiceClient) HIncrBy(ctx context.Context, req *connect.Request[v1.HIncrByRequest]) (*connect.Response[v1.HIncrByResponse], error) {
+	return c.hIncrBy.CallUnary(ctx, req)
+}
+
+// HMGet calls cache.v1.CacheService.HMGet.
+func (c *cacheServiceClient) HMGet(ctx context.Context, req *connect.Request[v1.HMGetRequest]) (*connect.Response[v1.HMGetResponse], error) {
+	return c.hMGet.CallUnary(ctx, req)
+}
+
+// HSet calls cache.v1.CacheService.HSet.
+func (c *cacheServiceClient) HSet(ctx context.Context, req *connect.Request[v1.HSetRequest]) (*connect.Response[v1.HSetResponse], error) {
+	return c.hSet.CallUnary(ctx, req)
+}
+
+// History calls cache.v1.CacheService.History.
+func (c *cacheServiceClient) History(ctx context.Context, req *connect.Request[v1.HistoryRequest]) (*connect.Response[v1.HistoryResponse], error) {
+	return c.history.CallUnary(ctx, req)
+}
+
+// Increment calls cache.v1.CacheService.Increment.
+func (c *cacheServiceClient) Increment(ctx context.Context, req *connect.Request[v1.IncrementRequest]) (*connect.Response[v1.IncrementResponse], error) {
+	return c.increment.CallUnary(ctx, req)
+}
+
+// InvalidateTags calls cache.v1.CacheService.InvalidateTags.
+func (c *cacheServiceClient) InvalidateTags(ctx context.Context, req *connect.Request[v1.InvalidateTagsRequest]) (*connect.Response[v1.InvalidateTagsResponse], error) {
+	return c.invalidateTags.CallUnary(ctx, req)
+}
+
+// LLen calls cache.v1.CacheService.LLen.
+func (c *cacheServiceClient) LLen(ctx context.Context, req *connect.Request[v1.LLenRequest]) (*connect.Response[v1.LLenResponse], error) {
+	return c.lLen.CallUnary(ctx, req)
+}
+
+// LPop calls cache.v1.CacheService.LPop.
+func (c *cacheServiceClient) LPop(ctx context.Context, req *connect.Request[v1.LPopRequest]) (*connect.Response[v1.LPopResponse], error) {
+	return c.lPop.CallUnary(ctx, req)
+}
+
+// LPush calls cache.v1.CacheService.LPush.
+func (c *cacheServiceClient) LPush(ctx context.Context, req *connect.Request[v1.LPushRequest]) (*connect.Response[v1.LPushResponse], error) {
+	return c.lPush.CallUnary(ctx, req)
+}
+
+// LRange calls cache.v1.CacheService.LRange.
+func (c *cacheServiceClient) LRange(ctx context.Context, req *connect.Request[v1.LRangeRequest]) (*connect.Response[v1.LRangeResponse], error) {
+	return c.lRange.CallUnary(ctx, req)
+}
+
+// LTrim calls cache.v1.CacheService.LTrim.
+func (c *cacheServiceClient) LTrim(ctx context.Context, req *connect.Request[v1.LTrimRequest]) (*connect.Response[v1.LTrimResponse], error) {
+	return c.lTrim.CallUnary(ctx, req)
+}
+
+// ListKeys calls cache.v1.CacheService.ListKeys.
+func (c *cacheServiceClient) ListKeys(ctx context.Context, req *connect.Request[v1.ListKeysRequest]) (*connect.Response[v1.ListKeysResponse], error) {
+	return c.listKeys.CallUnary(ctx, req)
+}
+
+// Persist calls cache.v1.CacheService.Persist.
+func (c *cacheServiceClient) Persist(ctx context.Context, req *connect.Request[v1.PersistRequest]) (*connect.Response[v1.PersistResponse], error) {
+	return c.persist.CallUnary(ctx, req)
+}
+
 // Purge calls cache.v1.CacheService.Purge.
 func (c *cacheServiceClient) Purge(ctx context.Context, req *connect.Request[v1.PurgeRequest]) (*connect.Response[v1.PurgeResponse], error) {
 	return c.purge.CallUnary(ctx, req)
 }
 
+// PutObject calls cache.v1.CacheService.PutObject.
+func (c *cacheServiceClient) PutObject(ctx context.Context) *connect.ClientStreamForClient[v1.PutObjectRequest, v1.PutObjectResponse] {
+	return c.putObject.CallClientStream(ctx)
+}
+
+// RPop calls cache.v1.CacheService.RPop.
+func (c *cacheServiceClient) RPop(ctx context.Context, req *connect.Request[v1.RPopRequest]) (*connect.Response[v1.RPopResponse], error) {
+	return c.rPop.CallUnary(ctx, req)
+}
+
+// RPush calls cache.v1.CacheService.RPush.
+func (c *cacheServiceClient) RPush(ctx context.Context, req *connect.Request[v1.RPushRequest]) (*connect.Response[v1.RPushResponse], error) {
+	return c.rPush.CallUnary(ctx, req)
+}
+
+// RateLimit calls cache.v1.CacheService.RateLimit.
+func (c *cacheServiceClient) RateLimit(ctx context.Context, req *connect.Request[v1.RateLimitRequest]) (*connect.Response[v1.RateLimitResponse], error) {
+	return c.rateLimit.CallUnary(ctx, req)
+}
+
+// Revert calls cache.v1.CacheService.Revert.
+func (c *cacheServiceClient) Revert(ctx context.Context, req *connect.Request[v1.RevertRequest]) (*connect.Response[v1.RevertResponse], error) {
+	return c.revert.CallUnary(ctx, req)
+}
+
+// SAdd calls cache.v1.CacheService.SAdd.
+func (c *cacheServiceClient) SAdd(ctx context.Context, req *connect.Request[v1.SAddRequest]) (*connect.Response[v1.SAddResponse], error) {
+	return c.sAdd.CallUnary(ctx, req)
+}
+
+// SCard calls cache.v1.CacheService.SCard.
+func (c *cacheServiceClient) SCard(ctx context.Context, req *connect.Request[v1.SCardRequest]) (*connect.Response[v1.SCardResponse], error) {
+	return c.sCard.CallUnary(ctx, req)
+}
+
+// SIsMember calls cache.v1.CacheService.SIsMember.
+func (c *cacheServiceClient) SIsMember(ctx context.Context, req *connect.Request[v1.SIsMemberRequest]) (*connect.Response[v1.SIsMemberResponse], error) {
+	return c.sIsMember.CallUnary(ctx, req)
+}
+
+// SMembers calls cache.v1.CacheService.SMembers.
+func (c *cacheServiceClient) SMembers(ctx context.Context, req *connect.Request[v1.SMembersRequest]) (*connect.Response[v1.SMembersResponse], error) {
+	return c.sMembers.CallUnary(ctx, req)
+}
+
+// SRem calls cache.v1.CacheService.SRem.
+func (c *cacheServiceClient) SRem(ctx context.Context, req *connect.Request[v1.SRemRequest]) (*connect.Response[v1.SRemResponse], error) {
+	return c.sRem.CallUnary(ctx, req)
+}
+
 // SetStream calls cache.v1.CacheService.SetStream.
 func (c *cacheServiceClient) SetStream(ctx context.Context) *connect.BidiStreamForClient[v1.SetRequest, v1.SetResponse] {
 	return c.setStream.CallBidiStream(ctx)
@@ -189,16 +973,99 @@ func (c *cacheServiceClient) Set(ctx context.Context, req *connect.Request[v1.Se
 	return c.set.CallUnary(ctx, req)
 }
 
+// SetMulti calls cache.v1.CacheService.SetMulti.
+func (c *cacheServiceClient) SetMulti(ctx context.Context, req *connect.Request[v1.SetMultiRequest]) (*connect.Response[v1.SetMultiResponse], error) {
+	return c.setMulti.CallUnary(ctx, req)
+}
+
+// Touch calls cache.v1.CacheService.Touch.
+func (c *cacheServiceClient) Touch(ctx context.Context, req *connect.Request[v1.TouchRequest]) (*connect.Response[v1.TouchResponse], error) {
+	return c.touch.CallUnary(ctx, req)
+}
+
+// TTL calls cache.v1.CacheService.TTL.
+func (c *cacheServiceClient) TTL(ctx context.Context, req *connect.Request[v1.TTLRequest]) (*connect.Response[v1.TTLResponse], error) {
+	return c.tTL.CallUnary(ctx, req)
+}
+
+// Watch calls cache.v1.CacheService.Watch.
+func (c *cacheServiceClient) Watch(ctx context.Context, req *connect.Request[v1.WatchRequest]) (*connect.ServerStreamForClient[v1.WatchResponse], error) {
+	return c.watch.CallServerStream(ctx, req)
+}
+
+// ZAdd calls cache.v1.CacheService.ZAdd.
+func (c *cacheServiceClient) ZAdd(ctx context.Context, req *connect.Request[v1.ZAddRequest]) (*connect.Response[v1.ZAddResponse], error) {
+	return c.zAdd.CallUnary(ctx, req)
+}
+
+// ZIncrBy calls cache.v1.CacheService.ZIncrBy.
+func (c *cacheServiceClient) ZIncrBy(ctx context.Context, req *connect.Request[v1.ZIncrByRequest]) (*connect.Response[v1.ZIncrByResponse], error) {
+	return c.zIncrBy.CallUnary(ctx, req)
+}
+
+// ZRange calls cache.v1.CacheService.ZRange.
+func (c *cacheServiceClient) ZRange(ctx context.Context, req *connect.Request[v1.ZRangeRequest]) (*connect.Response[v1.ZRangeResponse], error) {
+	return c.zRange.CallUnary(ctx, req)
+}
+
+// ZRank calls cache.v1.CacheService.ZRank.
+func (c *cacheServiceClient) ZRank(ctx context.Context, req *connect.Request[v1.ZRankRequest]) (*connect.Response[v1.ZRankResponse], error) {
+	return c.zRank.CallUnary(ctx, req)
+}
+
 // CacheServiceHandler is an implementation of the cache.v1.CacheService service.
 type CacheServiceHandler interface {
+	Decrement(context.Context, *connect.Request[v1.DecrementRequest]) (*connect.Response[v1.DecrementResponse], error)
 	Delete(context.Context, *connect.Request[v1.DeleteRequest]) (*connect.Response[v1.DeleteResponse], error)
+	DeleteMulti(context.Context, *connect.Request[v1.DeleteMultiRequest]) (*connect.Response[v1.DeleteMultiResponse], error)
 	Exists(context.Context, *connect.Request[v1.ExistsRequest]) (*connect.Response[v1.ExistsResponse], error)
+	ExpireAt(context.Context, *connect.Request[v1.ExpireAtRequest]) (*connect.Response[v1.ExpireAtResponse], error)
+	Fill(context.Context, *connect.Request[v1.FillRequest]) (*connect.Response[v1.FillResponse], error)
 	Get(context.Context, *connect.Request[v1.GetRequest]) (*connect.Response[v1.GetResponse], error)
+	GetAndDelete(context.Context, *connect.Request[v1.GetAndDeleteRequest]) (*connect.Response[v1.GetAndDeleteResponse], error)
+	GetAndSet(context.Context, *connect.Request[v1.GetAndSetRequest]) (*connect.Response[v1.GetAndSetResponse], error)
+	GetMetadata(context.Context, *connect.Request[v1.GetMetadataRequest]) (*connect.Response[v1.GetMetadataResponse], error)
 	GetMulti(context.Context, *connect.Request[v1.GetMultiRequest]) (*connect.Response[v1.GetMultiResponse], error)
+	GetObject(context.Context, *connect.Request[v1.GetObjectRequest], *connect.ServerStream[v1.GetObjectResponse]) error
+	GetOrLease(context.Context, *connect.Request[v1.GetOrLeaseRequest]) (*connect.Response[v1.GetOrLeaseResponse], error)
 	GetStream(context.Context, *connect.BidiStream[v1.GetRequest, v1.GetResponse]) error
+	HDel(context.Context, *connect.Request[v1.HDelRequest]) (*connect.Response[v1.HDelResponse], error)
+	HGet(context.Context, *connect.Request[v1.HGetRequest]) (*connect.Response[v1.HGetResponse], error)
+	HGetAll(context.Context, *connect.Request[v1.HGetAllRequest]) (*connect.Response[v1.HGetAllResponse], error)
+	HIncrBy(context.Context, *connect.Request[v1.HIncrByRequest]) (*connect.Response[v1.HIncrByResponse], error)
+	HMGet(context.Context, *connect.Request[v1.HMGetRequest]) (*connect.Response[v1.HMGetResponse], error)
+	HSet(context.Context, *connect.Request[v1.HSetRequest]) (*connect.Response[v1.HSetResponse], error)
+	History(context.Context, *connect.Request[v1.HistoryRequest]) (*connect.Response[v1.HistoryResponse], error)
+	Increment(context.Context, *connect.Request[v1.IncrementRequest]) (*connect.Response[v1.IncrementResponse], error)
+	InvalidateTags(context.Context, *connect.Request[v1.InvalidateTagsRequest]) (*connect.Response[v1.InvalidateTagsResponse], error)
+	LLen(context.Context, *connect.Request[v1.LLenRequest]) (*connect.Response[v1.LLenResponse], error)
+	LPop(context.Context, *connect.Request[v1.LPopRequest]) (*connect.Response[v1.LPopResponse], error)
+	LPush(context.Context, *connect.Request[v1.LPushRequest]) (*connect.Response[v1.LPushResponse], error)
+	LRange(context.Context, *connect.Request[v1.LRangeRequest]) (*connect.Response[v1.LRangeResponse], error)
+	LTrim(context.Context, *connect.Request[v1.LTrimRequest]) (*connect.Response[v1.LTrimResponse], error)
+	ListKeys(context.Context, *connect.Request[v1.ListKeysRequest]) (*connect.Response[v1.ListKeysResponse], error)
+	Persist(context.Context, *connect.Request[v1.PersistRequest]) (*connect.Response[v1.PersistResponse], error)
 	Purge(context.Context, *connect.Request[v1.PurgeRequest]) (*connect.Response[v1.PurgeResponse], error)
+	PutObject(context.Context, *connect.ClientStream[v1.PutObjectRequest]) (*connect.Response[v1.PutObjectResponse], error)
+	RPop(context.Context, *connect.Request[v1.RPopRequest]) (*connect.Response[v1.RPopResponse], error)
+	RPush(context.Context, *connect.Request[v1.RPushRequest]) (*connect.Response[v1.RPushResponse], error)
+	RateLimit(context.Context, *connect.Request[v1.RateLimitRequest]) (*connect.Response[v1.RateLimitResponse], error)
+	Revert(context.Context, *connect.Request[v1.RevertRequest]) (*connect.Response[v1.RevertResponse], error)
+	SAdd(context.Context, *connect.Request[v1.SAddRequest]) (*connect.Response[v1.SAddResponse], error)
+	SCard(context.Context, *connect.Request[v1.SCardRequest]) (*connect.Response[v1.SCardResponse], error)
+	SIsMember(context.Context, *connect.Request[v1.SIsMemberRequest]) (*connect.Response[v1.SIsMemberResponse], error)
+	SMembers(context.Context, *connect.Request[v1.SMembersRequest]) (*connect.Response[v1.SMembersResponse], error)
+	SRem(context.Context, *connect.Request[v1.SRemRequest]) (*connect.Response[v1.SRemResponse], error)
 	SetStream(context.Context, *connect.BidiStream[v1.SetRequest, v1.SetResponse]) error
 	Set(context.Context, *connect.Request[v1.SetRequest]) (*connect.Response[v1.SetResponse], error)
+	SetMulti(context.Context, *connect.Request[v1.SetMultiRequest]) (*connect.Response[v1.SetMultiResponse], error)
+	Touch(context.Context, *connect.Request[v1.TouchRequest]) (*connect.Response[v1.TouchResponse], error)
+	TTL(context.Context, *connect.Request[v1.TTLRequest]) (*connect.Response[v1.TTLResponse], error)
+	Watch(context.Context, *connect.Request[v1.WatchRequest], *connect.ServerStream[v1.WatchResponse]) error
+	ZAdd(context.Context, *connect.Request[v1.ZAddRequest]) (*connect.Response[v1.ZAddResponse], error)
+	ZIncrBy(context.Context, *connect.Request[v1.ZIncrByRequest]) (*connect.Response[v1.ZIncrByResponse], error)
+	ZRange(context.Context, *connect.Request[v1.ZRangeRequest]) (*connect.Response[v1.ZRangeResponse], error)
+	ZRank(context.Context, *connect.Request[v1.ZRankRequest]) (*connect.Response[v1.ZRankResponse], error)
 }
 
 // NewCacheServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -207,42 +1074,252 @@ type CacheServiceHandler interface {
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
 func NewCacheServiceHandler(svc CacheServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	cacheServiceDecrementHandler := connect.NewUnaryHandler(
+		CacheServiceDecrementProcedure,
+		svc.Decrement,
+		connect.WithSchema(cacheServiceDecrementMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	cacheServiceDeleteHandler := connect.NewUnaryHandler(
 		CacheServiceDeleteProcedure,
 		svc.Delete,
 		connect.WithSchema(cacheServiceDeleteMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	cacheServiceDeleteMultiHandler := connect.NewUnaryHandler(
+		CacheServiceDeleteMultiProcedure,
+		svc.DeleteMulti,
+		connect.WithSchema(cacheServiceDeleteMultiMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	cacheServiceExistsHandler := connect.NewUnaryHandler(
 		CacheServiceExistsProcedure,
 		svc.Exists,
 		connect.WithSchema(cacheServiceExistsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	cacheServiceExpireAtHandler := connect.NewUnaryHandler(
+		CacheServiceExpireAtProcedure,
+		svc.ExpireAt,
+		connect.WithSchema(cacheServiceExpireAtMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceFillHandler := connect.NewUnaryHandler(
+		CacheServiceFillProcedure,
+		svc.Fill,
+		connect.WithSchema(cacheServiceFillMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	cacheServiceGetHandler := connect.NewUnaryHandler(
 		CacheServiceGetProcedure,
 		svc.Get,
 		connect.WithSchema(cacheServiceGetMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	cacheServiceGetAndDeleteHandler := connect.NewUnaryHandler(
+		CacheServiceGetAndDeleteProcedure,
+		svc.GetAndDelete,
+		connect.WithSchema(cacheServiceGetAndDeleteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceGetAndSetHandler := connect.NewUnaryHandler(
+		CacheServiceGetAndSetProcedure,
+		svc.GetAndSet,
+		connect.WithSchema(cacheServiceGetAndSetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceGetMetadataHandler := connect.NewUnaryHandler(
+		CacheServiceGetMetadataProcedure,
+		svc.GetMetadata,
+		connect.WithSchema(cacheServiceGetMetadataMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	cacheServiceGetMultiHandler := connect.NewUnaryHandler(
 		CacheServiceGetMultiProcedure,
 		svc.GetMulti,
 		connect.WithSchema(cacheServiceGetMultiMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	cacheServiceGetObjectHandler := connect.NewServerStreamHandler(
+		CacheServiceGetObjectProcedure,
+		svc.GetObject,
+		connect.WithSchema(cacheServiceGetObjectMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceGetOrLeaseHandler := connect.NewUnaryHandler(
+		CacheServiceGetOrLeaseProcedure,
+		svc.GetOrLease,
+		connect.WithSchema(cacheServiceGetOrLeaseMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	cacheServiceGetStreamHandler := connect.NewBidiStreamHandler(
 		CacheServiceGetStreamProcedure,
 		svc.GetStream,
 		connect.WithSchema(cacheServiceGetStreamMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	cacheServiceHDelHandler := connect.NewUnaryHandler(
+		CacheServiceHDelProcedure,
+		svc.HDel,
+		connect.WithSchema(cacheServiceHDelMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceHGetHandler := connect.NewUnaryHandler(
+		CacheServiceHGetProcedure,
+		svc.HGet,
+		connect.WithSchema(cacheServiceHGetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceHGetAllHandler := connect.NewUnaryHandler(
+		CacheServiceHGetAllProcedure,
+		svc.HGetAll,
+		connect.WithSchema(cacheServiceHGetAllMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceHIncrByHandler := connect.NewUnaryHandler(
+		CacheServiceHIncrByProcedure,
+		svc.HIncrBy,
+		connect.WithSchema(cacheServiceHIncrByMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceHMGetHandler := connect.NewUnaryHandler(
+		CacheServiceHMGetProcedure,
+		svc.HMGet,
+		connect.WithSchema(cacheServiceHMGetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceHSetHandler := connect.NewUnaryHandler(
+		CacheServiceHSetProcedure,
+		svc.HSet,
+		connect.WithSchema(cacheServiceHSetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceHistoryHandler := connect.NewUnaryHandler(
+		CacheServiceHistoryProcedure,
+		svc.History,
+		connect.WithSchema(cacheServiceHistoryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceIncrementHandler := connect.NewUnaryHandler(
+		CacheServiceIncrementProcedure,
+		svc.Increment,
+		connect.WithSchema(cacheServiceIncrementMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceInvalidateTagsHandler := connect.NewUnaryHandler(
+		CacheServiceInvalidateTagsProcedure,
+		svc.InvalidateTags,
+		connect.WithSchema(cacheServiceInvalidateTagsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceLLenHandler := connect.NewUnaryHandler(
+		CacheServiceLLenProcedure,
+		svc.LLen,
+		connect.WithSchema(cacheServiceLLenMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceLPopHandler := connect.NewUnaryHandler(
+		CacheServiceLPopProcedure,
+		svc.LPop,
+		connect.WithSchema(cacheServiceLPopMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceLPushHandler := connect.NewUnaryHandler(
+		CacheServiceLPushProcedure,
+		svc.LPush,
+		connect.WithSchema(cacheServiceLPushMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceLRangeHandler := connect.NewUnaryHandler(
+		CacheServiceLRangeProcedure,
+		svc.LRange,
+		connect.WithSchema(cacheServiceLRangeMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceLTrimHandler := connect.NewUnaryHandler(
+		CacheServiceLTrimProcedure,
+		svc.LTrim,
+		connect.WithSchema(cacheServiceLTrimMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceListKeysHandler := connect.NewUnaryHandler(
+		CacheServiceListKeysProcedure,
+		svc.ListKeys,
+		connect.WithSchema(cacheServiceListKeysMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServicePersistHandler := connect.NewUnaryHandler(
+		CacheServicePersistProcedure,
+		svc.Persist,
+		connect.WithSchema(cacheServicePersistMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	cacheServicePurgeHandler := connect.NewUnaryHandler(
 		CacheServicePurgeProcedure,
 		svc.Purge,
 		connect.WithSchema(cacheServicePurgeMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	cacheServicePutObjectHandler := connect.NewClientStreamHandler(
+		CacheServicePutObjectProcedure,
+		svc.PutObject,
+		connect.WithSchema(cacheServicePutObjectMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceRPopHandler := connect.NewUnaryHandler(
+		CacheServiceRPopProcedure,
+		svc.RPop,
+		connect.WithSchema(cacheServiceRPopMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceRPushHandler := connect.NewUnaryHandler(
+		CacheServiceRPushProcedure,
+		svc.RPush,
+		connect.WithSchema(cacheServiceRPushMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceRateLimitHandler := connect.NewUnaryHandler(
+		CacheServiceRateLimitProcedure,
+		svc.RateLimit,
+		connect.WithSchema(cacheServiceRateLimitMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceRevertHandler := connect.NewUnaryHandler(
+		CacheServiceRevertProcedure,
+		svc.Revert,
+		connect.WithSchema(cacheServiceRevertMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceSAddHandler := connect.NewUnaryHandler(
+		CacheServiceSAddProcedure,
+		svc.SAdd,
+		connect.WithSchema(cacheServiceSAddMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceSCardHandler := connect.NewUnaryHandler(
+		CacheServiceSCardProcedure,
+		svc.SCard,
+		connect.WithSchema(cacheServiceSCardMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceSIsMemberHandler := connect.NewUnaryHandler(
+		CacheServiceSIsMemberProcedure,
+		svc.SIsMember,
+		connect.WithSchema(cacheServiceSIsMemberMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceSMembersHandler := connect.NewUnaryHandler(
+		CacheServiceSMembersProcedure,
+		svc.SMembers,
+		connect.WithSchema(cacheServiceSMembersMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceSRemHandler := connect.NewUnaryHandler(
+		CacheServiceSRemProcedure,
+		svc.SRem,
+		connect.WithSchema(cacheServiceSRemMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	cacheServiceSetStreamHandler := connect.NewBidiStreamHandler(
 		CacheServiceSetStreamProcedure,
 		svc.SetStream,
@@ -255,24 +1332,158 @@ func NewCacheServiceHandler(svc CacheServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(cacheServiceSetMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	cacheServiceSetMultiHandler := connect.NewUnaryHandler(
+		CacheServiceSetMultiProcedure,
+		svc.SetMulti,
+		connect.WithSchema(cacheServiceSetMultiMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceTouchHandler := connect.NewUnaryHandler(
+		CacheServiceTouchProcedure,
+		svc.Touch,
+		connect.WithSchema(cacheServiceTouchMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceTTLHandler := connect.NewUnaryHandler(
+		CacheServiceTTLProcedure,
+		svc.TTL,
+		connect.WithSchema(cacheServiceTTLMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceWatchHandler := connect.NewServerStreamHandler(
+		CacheServiceWatchProcedure,
+		svc.Watch,
+		connect.WithSchema(cacheServiceWatchMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceZAddHandler := connect.NewUnaryHandler(
+		CacheServiceZAddProcedure,
+		svc.ZAdd,
+		connect.WithSchema(cacheServiceZAddMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceZIncrByHandler := connect.NewUnaryHandler(
+		CacheServiceZIncrByProcedure,
+		svc.ZIncrBy,
+		connect.WithSchema(cacheServiceZIncrByMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceZRangeHandler := connect.NewUnaryHandler(
+		CacheServiceZRangeProcedure,
+		svc.ZRange,
+		connect.WithSchema(cacheServiceZRangeMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	cacheServiceZRankHandler := connect.NewUnaryHandler(
+		CacheServiceZRankProcedure,
+		svc.ZRank,
+		connect.WithSchema(cacheServiceZRankMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/cache.v1.CacheService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
+		case CacheServiceDecrementProcedure:
+			cacheServiceDecrementHandler.ServeHTTP(w, r)
 		case CacheServiceDeleteProcedure:
 			cacheServiceDeleteHandler.ServeHTTP(w, r)
+		case CacheServiceDeleteMultiProcedure:
+			cacheServiceDeleteMultiHandler.ServeHTTP(w, r)
 		case CacheServiceExistsProcedure:
 			cacheServiceExistsHandler.ServeHTTP(w, r)
+		case CacheServiceExpireAtProcedure:
+			cacheServiceExpireAtHandler.ServeHTTP(w, r)
+		case CacheServiceFillProcedure:
+			cacheServiceFillHandler.ServeHTTP(w, r)
 		case CacheServiceGetProcedure:
 			cacheServiceGetHandler.ServeHTTP(w, r)
+		case CacheServiceGetAndDeleteProcedure:
+			cacheServiceGetAndDeleteHandler.ServeHTTP(w, r)
+		case CacheServiceGetAndSetProcedure:
+			cacheServiceGetAndSetHandler.ServeHTTP(w, r)
+		case CacheServiceGetMetadataProcedure:
+			cacheServiceGetMetadataHandler.ServeHTTP(w, r)
 		case CacheServiceGetMultiProcedure:
 			cacheServiceGetMultiHandler.ServeHTTP(w, r)
+		case CacheServiceGetObjectProcedure:
+			cacheServiceGetObjectHandler.ServeHTTP(w, r)
+		case CacheServiceGetOrLeaseProcedure:
+			cacheServiceGetOrLeaseHandler.ServeHTTP(w, r)
 		case CacheServiceGetStreamProcedure:
 			cacheServiceGetStreamHandler.ServeHTTP(w, r)
+		case CacheServiceHDelProcedure:
+			cacheServiceHDelHandler.ServeHTTP(w, r)
+		case CacheServiceHGetProcedure:
+			cacheServiceHGetHandler.ServeHTTP(w, r)
+		case CacheServiceHGetAllProcedure:
+			cacheServiceHGetAllHandler.ServeHTTP(w, r)
+		case CacheServiceHIncrByProcedure:
+			cacheServiceHIncrByHandler.ServeHTTP(w, r)
+		case CacheServiceHMGetProcedure:
+			cacheServiceHMGetHandler.ServeHTTP(w, r)
+		case CacheServiceHSetProcedure:
+			cacheServiceHSetHandler.ServeHTTP(w, r)
+		case CacheServiceHistoryProcedure:
+			cacheServiceHistoryHandler.ServeHTTP(w, r)
+		case CacheServiceIncrementProcedure:
+			cacheServiceIncrementHandler.ServeHTTP(w, r)
+		case CacheServiceInvalidateTagsProcedure:
+			cacheServiceInvalidateTagsHandler.ServeHTTP(w, r)
+		case CacheServiceLLenProcedure:
+			cacheServiceLLenHandler.ServeHTTP(w, r)
+		case CacheServiceLPopProcedure:
+			cacheServiceLPopHandler.ServeHTTP(w, r)
+		case CacheServiceLPushProcedure:
+			cacheServiceLPushHandler.ServeHTTP(w, r)
+		case CacheServiceLRangeProcedure:
+			cacheServiceLRangeHandler.ServeHTTP(w, r)
+		case CacheServiceLTrimProcedure:
+			cacheServiceLTrimHandler.ServeHTTP(w, r)
+		case CacheServiceListKeysProcedure:
+			cacheServiceListKeysHandler.ServeHTTP(w, r)
+		case CacheServicePersistProcedure:
+			cacheServicePersistHandler.ServeHTTP(w, r)
 		case CacheServicePurgeProcedure:
 			cacheServicePurgeHandler.ServeHTTP(w, r)
+		case CacheServicePutObjectProcedure:
+			cacheServicePutObjectHandler.ServeHTTP(w, r)
+		case CacheServiceRPopProcedure:
+			cacheServiceRPopHandler.ServeHTTP(w, r)
+		case CacheServiceRPushProcedure:
+			cacheServiceRPushHandler.ServeHTTP(w, r)
+		case CacheServiceRateLimitProcedure:
+			cacheServiceRateLimitHandler.ServeHTTP(w, r)
+		case CacheServiceRevertProcedure:
+			cacheServiceRevertHandler.ServeHTTP(w, r)
+		case CacheServiceSAddProcedure:
+			cacheServiceSAddHandler.ServeHTTP(w, r)
+		case CacheServiceSCardProcedure:
+			cacheServiceSCardHandler.ServeHTTP(w, r)
+		case CacheServiceSIsMemberProcedure:
+			cacheServiceSIsMemberHandler.ServeHTTP(w, r)
+		case CacheServiceSMembersProcedure:
+			cacheServiceSMembersHandler.ServeHTTP(w, r)
+		case CacheServiceSRemProcedure:
+			cacheServiceSRemHandler.ServeHTTP(w, r)
 		case CacheServiceSetStreamProcedure:
 			cacheServiceSetStreamHandler.ServeHTTP(w, r)
 		case CacheServiceSetProcedure:
 			cacheServiceSetHandler.ServeHTTP(w, r)
+		case CacheServiceSetMultiProcedure:
+			cacheServiceSetMultiHandler.ServeHTTP(w, r)
+		case CacheServiceTouchProcedure:
+			cacheServiceTouchHandler.ServeHTTP(w, r)
+		case CacheServiceTTLProcedure:
+			cacheServiceTTLHandler.ServeHTTP(w, r)
+		case CacheServiceWatchProcedure:
+			cacheServiceWatchHandler.ServeHTTP(w, r)
+		case CacheServiceZAddProcedure:
+			cacheServiceZAddHandler.ServeHTTP(w, r)
+		case CacheServiceZIncrByProcedure:
+			cacheServiceZIncrByHandler.ServeHTTP(w, r)
+		case CacheServiceZRangeProcedure:
+			cacheServiceZRangeHandler.ServeHTTP(w, r)
+		case CacheServiceZRankProcedure:
+			cacheServiceZRankHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -282,30 +1493,170 @@ func NewCacheServiceHandler(svc CacheServiceHandler, opts ...connect.HandlerOpti
 // UnimplementedCacheServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedCacheServiceHandler struct{}
 
+func (UnimplementedCacheServiceHandler) Decrement(context.Context, *connect.Request[v1.DecrementRequest]) (*connect.Response[v1.DecrementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.Decrement is not implemented"))
+}
+
 func (UnimplementedCacheServiceHandler) Delete(context.Context, *connect.Request[v1.DeleteRequest]) (*connect.Response[v1.DeleteResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.Delete is not implemented"))
 }
 
+func (UnimplementedCacheServiceHandler) DeleteMulti(context.Context, *connect.Request[v1.DeleteMultiRequest]) (*connect.Response[v1.DeleteMultiResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.DeleteMulti is not implemented"))
+}
+
 func (UnimplementedCacheServiceHandler) Exists(context.Context, *connect.Request[v1.ExistsRequest]) (*connect.Response[v1.ExistsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.Exists is not implemented"))
 }
 
+func (UnimplementedCacheServiceHandler) ExpireAt(context.Context, *connect.Request[v1.ExpireAtRequest]) (*connect.Response[v1.ExpireAtResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.ExpireAt is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) Fill(context.Context, *connect.Request[v1.FillRequest]) (*connect.Response[v1.FillResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.Fill is not implemented"))
+}
+
 func (UnimplementedCacheServiceHandler) Get(context.Context, *connect.Request[v1.GetRequest]) (*connect.Response[v1.GetResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.Get is not implemented"))
 }
 
+func (UnimplementedCacheServiceHandler) GetAndDelete(context.Context, *connect.Request[v1.GetAndDeleteRequest]) (*connect.Response[v1.GetAndDeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.GetAndDelete is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) GetAndSet(context.Context, *connect.Request[v1.GetAndSetRequest]) (*connect.Response[v1.GetAndSetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.GetAndSet is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) GetMetadata(context.Context, *connect.Request[v1.GetMetadataRequest]) (*connect.Response[v1.GetMetadataResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.GetMetadata is not implemented"))
+}
+
 func (UnimplementedCacheServiceHandler) GetMulti(context.Context, *connect.Request[v1.GetMultiRequest]) (*connect.Response[v1.GetMultiResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.GetMulti is not implemented"))
 }
 
+func (UnimplementedCacheServiceHandler) GetObject(context.Context, *connect.Request[v1.GetObjectRequest], *connect.ServerStream[v1.GetObjectResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.GetObject is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) GetOrLease(context.Context, *connect.Request[v1.GetOrLeaseRequest]) (*connect.Response[v1.GetOrLeaseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.GetOrLease is not implemented"))
+}
+
 func (UnimplementedCacheServiceHandler) GetStream(context.Context, *connect.BidiStream[v1.GetRequest, v1.GetResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.GetStream is not implemented"))
 }
 
+func (UnimplementedCacheServiceHandler) HDel(context.Context, *connect.Request[v1.HDelRequest]) (*connect.Response[v1.HDelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.HDel is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) HGet(context.Context, *connect.Request[v1.HGetRequest]) (*connect.Response[v1.HGetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.HGet is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) HGetAll(context.Context, *connect.Request[v1.HGetAllRequest]) (*connect.Response[v1.HGetAllResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.HGetAll is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) HIncrBy(context.Context, *connect.Request[v1.HIncrByRequest]) (*connect.Response[v1.HIncrByResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.HIncrBy is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) HMGet(context.Context, *connect.Request[v1.HMGetRequest]) (*connect.Response[v1.HMGetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.HMGet is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) HSet(context.Context, *connect.Request[v1.HSetRequest]) (*connect.Response[v1.HSetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.HSet is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) History(context.Context, *connect.Request[v1.HistoryRequest]) (*connect.Response[v1.HistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.History is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) Increment(context.Context, *connect.Request[v1.IncrementRequest]) (*connect.Response[v1.IncrementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.Increment is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) InvalidateTags(context.Context, *connect.Request[v1.InvalidateTagsRequest]) (*connect.Response[v1.InvalidateTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.InvalidateTags is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) LLen(context.Context, *connect.Request[v1.LLenRequest]) (*connect.Response[v1.LLenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.LLen is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) LPop(context.Context, *connect.Request[v1.LPopRequest]) (*connect.Response[v1.LPopResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.LPop is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) LPush(context.Context, *connect.Request[v1.LPushRequest]) (*connect.Response[v1.LPushResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.LPush is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) LRange(context.Context, *connect.Request[v1.LRangeRequest]) (*connect.Response[v1.LRangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.LRange is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) LTrim(context.Context, *connect.Request[v1.LTrimRequest]) (*connect.Response[v1.LTrimResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.LTrim is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) ListKeys(context.Context, *connect.Request[v1.ListKeysRequest]) (*connect.Response[v1.ListKeysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.ListKeys is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) Persist(context.Context, *connect.Request[v1.PersistRequest]) (*connect.Response[v1.PersistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.Persist is not implemented"))
+}
+
 func (UnimplementedCacheServiceHandler) Purge(context.Context, *connect.Request[v1.PurgeRequest]) (*connect.Response[v1.PurgeResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.Purge is not implemented"))
 }
 
+func (UnimplementedCacheServiceHandler) PutObject(context.Context, *connect.ClientStream[v1.PutObjectRequest]) (*connect.Response[v1.PutObjectResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.PutObject is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) RPop(context.Context, *connect.Request[v1.RPopRequest]) (*connect.Response[v1.RPopResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.RPop is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) RPush(context.Context, *connect.Request[v1.RPushRequest]) (*connect.Response[v1.RPushResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.RPush is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) RateLimit(context.Context, *connect.Request[v1.RateLimitRequest]) (*connect.Response[v1.RateLimitResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.RateLimit is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) Revert(context.Context, *connect.Request[v1.RevertRequest]) (*connect.Response[v1.RevertResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.Revert is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) SAdd(context.Context, *connect.Request[v1.SAddRequest]) (*connect.Response[v1.SAddResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.SAdd is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) SCard(context.Context, *connect.Request[v1.SCardRequest]) (*connect.Response[v1.SCardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.SCard is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) SIsMember(context.Context, *connect.Request[v1.SIsMemberRequest]) (*connect.Response[v1.SIsMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.SIsMember is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) SMembers(context.Context, *connect.Request[v1.SMembersRequest]) (*connect.Response[v1.SMembersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.SMembers is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) SRem(context.Context, *connect.Request[v1.SRemRequest]) (*connect.Response[v1.SRemResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.SRem is not implemented"))
+}
+
 func (UnimplementedCacheServiceHandler) SetStream(context.Context, *connect.BidiStream[v1.SetRequest, v1.SetResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.SetStream is not implemented"))
 }
@@ -313,3 +1664,35 @@ func (UnimplementedCacheServiceHandler) SetStream(context.Context, *connect.Bidi
 func (UnimplementedCacheServiceHandler) Set(context.Context, *connect.Request[v1.SetRequest]) (*connect.Response[v1.SetResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.Set is not implemented"))
 }
+
+func (UnimplementedCacheServiceHandler) SetMulti(context.Context, *connect.Request[v1.SetMultiRequest]) (*connect.Response[v1.SetMultiResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.SetMulti is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) Touch(context.Context, *connect.Request[v1.TouchRequest]) (*connect.Response[v1.TouchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.Touch is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) TTL(context.Context, *connect.Request[v1.TTLRequest]) (*connect.Response[v1.TTLResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.TTL is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) Watch(context.Context, *connect.Request[v1.WatchRequest], *connect.ServerStream[v1.WatchResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.Watch is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) ZAdd(context.Context, *connect.Request[v1.ZAddRequest]) (*connect.Response[v1.ZAddResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.ZAdd is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) ZIncrBy(context.Context, *connect.Request[v1.ZIncrByRequest]) (*connect.Response[v1.ZIncrByResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.ZIncrBy is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) ZRange(context.Context, *connect.Request[v1.ZRangeRequest]) (*connect.Response[v1.ZRangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.ZRange is not implemented"))
+}
+
+func (UnimplementedCacheServiceHandler) ZRank(context.Context, *connect.Request[v1.ZRankRequest]) (*connect.Response[v1.ZRankResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cache.v1.CacheService.ZRank is not implemented"))
+}
@@ -0,0 +1,55 @@
+package keygen
+
+import (
+	"encoding/base64"
+	"strings"
+)
+
+// escapePrefix marks a key that was escaped, keys starting with it are always escaped so the escaping
+// can be reversed.
+const escapePrefix = "="
+
+// Escape returns a key the bucket can store for a key sent by a client that does not restrict keys, such
+// as Redis and memcached clients. Keys the bucket accepts are returned as is so they are shared with the
+// cache service, any other key is base64url encoded after the escape prefix.
+func Escape(key string) string {
+	if storable(key) && !strings.HasPrefix(key, escapePrefix) {
+		return key
+	}
+
+	return escapePrefix + base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+// Unescape returns the key sent by the client for a key created by Escape. Keys that are not escaped, or
+// were written by the cache service and can not be decoded, are returned as is.
+func Unescape(key string) string {
+	if !strings.HasPrefix(key, escapePrefix) {
+		return key
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(key, escapePrefix))
+	if err != nil {
+		return key
+	}
+
+	return string(b)
+}
+
+// storable reports whether the bucket accepts the key after the subject and database, the characters
+// are limited to -/_=.a-zA-Z0-9 and the dots may not create an empty token.
+func storable(key string) bool {
+	if key == "" || strings.HasSuffix(key, ".") || strings.Contains(key, "..") {
+		return false
+	}
+
+	for i := 0; i < len(key); i++ {
+		switch c := key[i]; {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '-', c == '/', c == '_', c == '=', c == '.':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
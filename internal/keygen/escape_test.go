@@ -0,0 +1,50 @@
+package keygen
+
+import "testing"
+
+func TestEscape(t *testing.T) {
+	tests := []struct {
+		name string
+		key  string
+		want string
+	}{
+		{name: "should keep a key the bucket accepts", key: "user.42/profile_v=1", want: "user.42/profile_v=1"},
+		{name: "should escape a colon", key: "user:42", want: "=dXNlcjo0Mg"},
+		{name: "should escape spaces and unicode", key: "ü k", want: "=w7wgaw"},
+		{name: "should escape wildcards", key: "a.*", want: "=YS4q"},
+		{name: "should escape a trailing dot", key: "a.", want: "=YS4"},
+		{name: "should escape an empty token", key: "a..b", want: "=YS4uYg"},
+		{name: "should escape a key with the escape prefix", key: "=abc", want: "=PWFiYw"},
+		{name: "should escape an empty key", key: "", want: "="},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Escape(tt.key)
+			if got != tt.want {
+				t.Errorf("Escape() = %q, want %q", got, tt.want)
+			}
+
+			if back := Unescape(got); back != tt.key {
+				t.Errorf("Unescape() = %q, want %q", back, tt.key)
+			}
+		})
+	}
+}
+
+func TestUnescape(t *testing.T) {
+	tests := []struct {
+		name string
+		key  string
+		want string
+	}{
+		{name: "should keep a key that is not escaped", key: "user.42", want: "user.42"},
+		{name: "should keep a key that can not be decoded", key: "=a", want: "=a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Unescape(tt.key); got != tt.want {
+				t.Errorf("Unescape() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
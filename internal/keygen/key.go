@@ -11,3 +11,32 @@ import (
 func FromToken(t auth.Token, db uint32, key string) (string, string, error) {
 	return fmt.Sprintf("%s.%d-%s", t.Subject, db, key), key, nil
 }
+
+// ForKind creates an internal key for data managed by the service, such as locks, and returns the
+// internal key, the original key and an error if one occurred. The key is scoped to the subject and
+// database like FromToken but the kind is placed after the database so it never collides with a key
+// created by FromToken.
+func ForKind(t auth.Token, db uint32, kind, key string) (string, string, error) {
+	return fmt.Sprintf("%s.%d.%s-%s", t.Subject, db, kind, key), key, nil
+}
+
+// Subject returns the subject an internal key created by FromToken or ForKind belongs to. The subject
+// ends at the first dot followed by the database, false is returned if the key has no subject.
+func Subject(internalKey string) (string, bool) {
+	for i := 0; i < len(internalKey); i++ {
+		if internalKey[i] != '.' {
+			continue
+		}
+
+		j := i + 1
+		for j < len(internalKey) && internalKey[j] >= '0' && internalKey[j] <= '9' {
+			j++
+		}
+
+		if j > i+1 && j < len(internalKey) && (internalKey[j] == '-' || internalKey[j] == '.') {
+			return internalKey[:i], true
+		}
+	}
+
+	return "", false
+}
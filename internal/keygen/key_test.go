@@ -49,3 +49,74 @@ func TestFromToken(t *testing.T) {
 		})
 	}
 }
+
+func TestForKind(t *testing.T) {
+	type args struct {
+		t    auth.Token
+		db   uint32
+		kind string
+		key  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		want1   string
+		wantErr bool
+	}{
+		{
+			name: "should return the correct key",
+			args: args{
+				t: auth.Token{
+					Subject: "test",
+				},
+				db:   1,
+				kind: "lock",
+				key:  "test",
+			},
+			want:    "test.1.lock-test",
+			want1:   "test",
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, err := ForKind(tt.args.t, tt.args.db, tt.args.kind, tt.args.key)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ForKind() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ForKind() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("ForKind() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestSubject(t *testing.T) {
+	tests := []struct {
+		name  string
+		key   string
+		want  string
+		want1 bool
+	}{
+		{name: "should return the subject of a key", key: "test.1-test", want: "test", want1: true},
+		{name: "should return the subject of a kind", key: "test.1.lock-test", want: "test", want1: true},
+		{name: "should allow dots in the subject", key: "user.example.com.0-a.1-b", want: "user.example.com", want1: true},
+		{name: "should return false without a database", key: "test", want: "", want1: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := Subject(tt.key)
+			if got != tt.want {
+				t.Errorf("Subject() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("Subject() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package keygen
+
+// Match reports whether the key matches the glob pattern. A * matches any sequence of characters,
+// a ? matches a single character and a \ escapes the next character in the pattern.
+func Match(pattern, key string) bool {
+	p, k := 0, 0
+	// the position of the last * in the pattern and the key position it is matching from
+	star, next := -1, 0
+
+	for k < len(key) {
+		switch {
+		case p < len(pattern) && pattern[p] == '*':
+			star, next = p, k
+			p++
+			continue
+		case p < len(pattern) && pattern[p] == '?':
+			p++
+			k++
+			continue
+		case p < len(pattern) && pattern[p] == '\\' && p+1 < len(pattern) && pattern[p+1] == key[k]:
+			p += 2
+			k++
+			continue
+		case p < len(pattern) && pattern[p] != '\\' && pattern[p] == key[k]:
+			p++
+			k++
+			continue
+		}
+
+		// backtrack and let the last * match one more character
+		if star < 0 {
+			return false
+		}
+
+		next++
+		p, k = star+1, next
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+
+	return p == len(pattern)
+}
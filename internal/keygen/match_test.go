@@ -0,0 +1,30 @@
+package keygen
+
+import "testing"
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		key     string
+		want    bool
+	}{
+		{name: "should match an exact key", pattern: "user", key: "user", want: true},
+		{name: "should not match a different key", pattern: "user", key: "users", want: false},
+		{name: "should match any suffix", pattern: "user:*", key: "user:42:profile", want: true},
+		{name: "should match any infix", pattern: "user:*:profile", key: "user:42:profile", want: true},
+		{name: "should match an empty sequence", pattern: "user*", key: "user", want: true},
+		{name: "should match a single character", pattern: "user:?", key: "user:4", want: true},
+		{name: "should not match multiple characters with ?", pattern: "user:?", key: "user:42", want: false},
+		{name: "should match an escaped character", pattern: `user\*`, key: "user*", want: true},
+		{name: "should not treat an escaped * as a wildcard", pattern: `user\*`, key: "users", want: false},
+		{name: "should backtrack", pattern: "*a*b", key: "xaxxbab", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Match(tt.pattern, tt.key); got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
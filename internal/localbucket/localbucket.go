@@ -3,9 +3,12 @@ package localbucket
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/jasonmccallister/nats-cache/getenv"
+	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
 	"os"
+	"strconv"
 )
 
 // OptionsFunc is a function that sets options for the bucket
@@ -39,6 +42,14 @@ func WithStorage(storage jetstream.StorageType) OptionsFunc {
 	}
 }
 
+// WithHistory sets the number of revisions kept for each key in the bucket, up to 64. The history of an
+// existing bucket is updated to match, Create returns an error if the history is more than 64.
+func WithHistory(history uint8) OptionsFunc {
+	return func(o *Option) {
+		o.History = history
+	}
+}
+
 func defaultOptions() Option {
 	return Option{
 		BucketName:       "cache",
@@ -53,39 +64,73 @@ type Option struct {
 	StreamSourceName string
 	Storage          jetstream.StorageType
 	MaxBytes         int64
+	// History is the number of revisions kept for each key, if it is 0 a new bucket keeps 1 revision and
+	// the history of an existing bucket is left as is.
+	History uint8
 }
 
 // CreateFromEnv sets the default options for the bucket and checks the environment for overrides.
 func CreateFromEnv(ctx context.Context, js jetstream.JetStream) (jetstream.KeyValue, error) {
-	opts := defaultOptions()
+	opts, err := optionsFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	kv, err := Create(ctx, js, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return kv, nil
+}
+
+// CreateObjectStoreFromEnv creates the object store for the bucket using the same environment overrides
+// as CreateFromEnv.
+func CreateObjectStoreFromEnv(js nats.JetStreamContext) (nats.ObjectStore, error) {
+	opts, err := optionsFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	return CreateObjectStore(js, opts...)
+}
+
+// optionsFromEnv returns the options for the bucket set in the environment or an error if an option is
+// not valid.
+func optionsFromEnv() ([]OptionsFunc, error) {
+	var opts []OptionsFunc
 
 	if v, ok := os.LookupEnv("NATS_BUCKET_NAME"); ok {
-		opts.BucketName = v
+		opts = append(opts, WithBucketName(v))
 	}
 
 	if _, ok := os.LookupEnv("NATS_BUCKET_MAX_BYTES"); ok {
-		opts.MaxBytes = getenv.Int64("NATS_BUCKET_MAX_BYTES", 1024*1024*1024)
+		opts = append(opts, WithMaxBytes(getenv.Int64("NATS_BUCKET_MAX_BYTES", 1024*1024*1024)))
+	}
+
+	if v, ok := os.LookupEnv("NATS_BUCKET_HISTORY"); ok {
+		history, err := strconv.Atoi(v)
+		if err != nil || history < 1 || history > jetstream.KeyValueMaxHistory {
+			return nil, fmt.Errorf("NATS_BUCKET_HISTORY must be between 1 and %d, got %q", jetstream.KeyValueMaxHistory, v)
+		}
+
+		opts = append(opts, WithHistory(uint8(history)))
 	}
 
 	if v, ok := os.LookupEnv("NATS_STREAM_SOURCE_NAME"); ok {
-		opts.StreamSourceName = v
+		opts = append(opts, WithStreamSourceName(v))
 	}
 
 	if v, ok := os.LookupEnv("NATS_LOCAL_STORAGE"); ok {
 		switch v {
 		case "memory":
-			opts.Storage = jetstream.MemoryStorage
+			opts = append(opts, WithStorage(jetstream.MemoryStorage))
 		default:
-			opts.Storage = jetstream.FileStorage
+			opts = append(opts, WithStorage(jetstream.FileStorage))
 		}
 	}
 
-	kv, err := Create(ctx, js)
-	if err != nil {
-		return nil, err
-	}
-
-	return kv, nil
+	return opts, nil
 }
 
 // Create creates a new bucket if it does not exist or returns the existing bucket
@@ -95,9 +140,18 @@ func Create(ctx context.Context, js jetstream.JetStream, opts ...OptionsFunc) (j
 		fn(&o)
 	}
 
+	if o.History > jetstream.KeyValueMaxHistory {
+		return nil, fmt.Errorf("history must be between 1 and %d, got %d", jetstream.KeyValueMaxHistory, o.History)
+	}
+
 	kv, err := js.KeyValue(ctx, o.BucketName)
 	if err != nil {
 		if errors.Is(err, jetstream.ErrBucketNotFound) {
+			history := o.History
+			if history == 0 {
+				history = 1
+			}
+
 			kv, err := js.CreateKeyValue(ctx, jetstream.KeyValueConfig{
 				Bucket: o.BucketName,
 				Mirror: &jetstream.StreamSource{
@@ -108,6 +162,7 @@ func Create(ctx context.Context, js jetstream.JetStream, opts ...OptionsFunc) (j
 				},
 				Storage:  o.Storage,
 				MaxBytes: o.MaxBytes,
+				History:  history,
 			})
 			if err != nil {
 				return nil, err
@@ -115,7 +170,74 @@ func Create(ctx context.Context, js jetstream.JetStream, opts ...OptionsFunc) (j
 
 			return kv, nil
 		}
+
+		return nil, err
+	}
+
+	if o.History > 0 {
+		if err := updateHistory(ctx, js, kv, o.History); err != nil {
+			return nil, err
+		}
 	}
 
 	return kv, nil
 }
+
+// updateHistory changes the number of revisions kept for each key of an existing bucket, the history of
+// a bucket is the max messages per subject of its stream.
+func updateHistory(ctx context.Context, js jetstream.JetStream, kv jetstream.KeyValue, history uint8) error {
+	status, err := kv.Status(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get bucket status: %w", err)
+	}
+
+	if status.History() == int64(history) {
+		return nil
+	}
+
+	stream, err := js.Stream(ctx, "KV_"+kv.Bucket())
+	if err != nil {
+		return fmt.Errorf("failed to get bucket stream: %w", err)
+	}
+
+	cfg := stream.CachedInfo().Config
+	cfg.MaxMsgsPerSubject = int64(history)
+
+	if _, err := js.UpdateStream(ctx, cfg); err != nil {
+		return fmt.Errorf("failed to update bucket history: %w", err)
+	}
+
+	return nil
+}
+
+// CreateObjectStore creates the object store used for values that are too large for the bucket if it does
+// not exist or returns the existing object store. The object store is named after the bucket with an
+// -objects suffix and uses the same storage and max bytes.
+func CreateObjectStore(js nats.JetStreamContext, opts ...OptionsFunc) (nats.ObjectStore, error) {
+	o := defaultOptions()
+	for _, fn := range opts {
+		fn(&o)
+	}
+
+	name := o.BucketName + "-objects"
+
+	obs, err := js.ObjectStore(name)
+	if err == nil {
+		return obs, nil
+	}
+
+	if !errors.Is(err, nats.ErrStreamNotFound) {
+		return nil, err
+	}
+
+	storage := nats.FileStorage
+	if o.Storage == jetstream.MemoryStorage {
+		storage = nats.MemoryStorage
+	}
+
+	return js.CreateObjectStore(&nats.ObjectStoreConfig{
+		Bucket:   name,
+		Storage:  storage,
+		MaxBytes: o.MaxBytes,
+	})
+}
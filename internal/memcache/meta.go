@@ -0,0 +1,275 @@
+package memcache
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/keygen"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+// metaReply returns the flags requested by the client for the entry in the order they were requested.
+// Opaque tokens are returned as they were sent.
+func metaReply(key []byte, e *storage.Entry, requested []string) string {
+	var b strings.Builder
+	for _, f := range requested {
+		b.WriteByte(' ')
+
+		switch f[0] {
+		case 'f':
+			b.WriteString("f" + strconv.FormatUint(uint64(e.Flags), 10))
+		case 'c':
+			b.WriteString("c" + strconv.FormatUint(e.Revision, 10))
+		case 't':
+			b.WriteString("t" + strconv.FormatInt(remaining(e.TTL), 10))
+		case 's':
+			b.WriteString("s" + strconv.Itoa(len(e.Value)))
+		case 'k':
+			b.WriteString("k" + string(key))
+		default:
+			b.WriteString(f)
+		}
+	}
+
+	return b.String()
+}
+
+// metaGet runs the mg command. The flags supported are v (value), f (client flags), c (cas), t (remaining
+// ttl), s (size), k (key), O (opaque), q (no reply on a miss) and T (update the ttl). Stale values are
+// returned with the X flag.
+func (s *Server) metaGet(ctx context.Context, sess *session, args [][]byte) {
+	if len(args) < 2 || !validKey(args[1]) {
+		sess.w.line("CLIENT_ERROR bad command line format")
+		return
+	}
+
+	var value, quiet, touch bool
+	var exptime int64
+	var requested []string
+	for _, f := range args[2:] {
+		switch f[0] {
+		case 'v':
+			value = true
+		case 'q':
+			quiet = true
+		case 'f', 'c', 't', 's', 'k', 'O':
+			requested = append(requested, string(f))
+		case 'T':
+			n, err := strconv.ParseInt(string(f[1:]), 10, 64)
+			if err != nil {
+				sess.w.line("CLIENT_ERROR bad token in command line format")
+				return
+			}
+
+			touch, exptime = true, n
+		default:
+			sess.w.line("CLIENT_ERROR invalid flag")
+			return
+		}
+	}
+
+	key := s.key(sess, args[1])
+
+	e, err := s.Keyspace.Get(ctx, *sess.token, 0, keygen.Escape(string(args[1])))
+	if err != nil {
+		s.storeError(ctx, sess, "get", err)
+		return
+	}
+
+	// values stored as objects are not returned since they are too large for a reply
+	if e == nil || e.Object != "" {
+		if !quiet {
+			sess.w.line("EN")
+		}
+
+		return
+	}
+
+	if touch {
+		ttl := expiration(exptime)
+
+		revision, err := s.Keyspace.Store.Touch(ctx, key, ttl)
+		if errors.Is(err, storage.ErrKeyNotFound) {
+			if !quiet {
+				sess.w.line("EN")
+			}
+
+			return
+		}
+
+		if err != nil {
+			s.storeError(ctx, sess, "touch", err)
+			return
+		}
+
+		e.TTL, e.Revision = ttl, revision
+	}
+
+	reply := metaReply(args[1], e, requested)
+
+	// a stale value is still returned, the client is told so it can refresh the value
+	if e.IsStale() {
+		reply += " X"
+	}
+
+	if !value {
+		sess.w.line("HD" + reply)
+		return
+	}
+
+	sess.w.line("VA " + strconv.Itoa(len(e.Value)) + reply)
+	sess.w.data(e.Value)
+}
+
+// metaSet runs the ms command. The flags supported are F (client flags), T (ttl), C (compare cas), M (mode
+// S for set, E for add and R for replace), c (return cas), k (return key), O (opaque) and q (no reply on
+// success). The data block is always read so the next command can be parsed.
+func (s *Server) metaSet(ctx context.Context, sess *session, args [][]byte) error {
+	if len(args) < 3 {
+		return errBadFormat
+	}
+
+	n, ok := parseLength(args[2], maxValueLength)
+	if !ok {
+		return errBadFormat
+	}
+
+	data, err := sess.r.readData(n)
+	if err != nil {
+		return err
+	}
+
+	if !validKey(args[1]) {
+		sess.w.line("CLIENT_ERROR bad command line format")
+		return nil
+	}
+
+	i := storage.Item{Value: data}
+	mode := byte('S')
+	var quiet bool
+	var cas uint64
+	var requested []string
+	for _, f := range args[3:] {
+		var err error
+		switch f[0] {
+		case 'F':
+			var flags uint64
+			flags, err = strconv.ParseUint(string(f[1:]), 10, 32)
+			i.Flags = uint32(flags)
+		case 'T':
+			var exptime int64
+			exptime, err = strconv.ParseInt(string(f[1:]), 10, 64)
+			i.TTL = expiration(exptime)
+		case 'C':
+			cas, err = strconv.ParseUint(string(f[1:]), 10, 64)
+		case 'M':
+			if len(f) != 2 || !strings.ContainsRune("SER", rune(f[1])) {
+				sess.w.line("CLIENT_ERROR invalid mode for ms")
+				return nil
+			}
+
+			mode = f[1]
+		case 'q':
+			quiet = true
+		case 'c', 'k', 'O':
+			requested = append(requested, string(f))
+		default:
+			sess.w.line("CLIENT_ERROR invalid flag")
+			return nil
+		}
+
+		if err != nil {
+			sess.w.line("CLIENT_ERROR bad token in command line format")
+			return nil
+		}
+	}
+
+	key := keygen.Escape(string(args[1]))
+
+	var revision uint64
+	switch {
+	case mode == 'E':
+		revision, err = s.Keyspace.Set(ctx, *sess.token, 0, key, i, cachev1.SetCondition_SET_CONDITION_IF_NOT_EXISTS, 0)
+	case cas != 0:
+		revision, err = s.compareAndSet(ctx, sess, key, i, cas)
+	case mode == 'R':
+		revision, err = s.Keyspace.Set(ctx, *sess.token, 0, key, i, cachev1.SetCondition_SET_CONDITION_IF_EXISTS, 0)
+	default:
+		revision, err = s.Keyspace.Set(ctx, *sess.token, 0, key, i, cachev1.SetCondition_SET_CONDITION_UNSPECIFIED, 0)
+	}
+
+	switch {
+	case errors.Is(err, storage.ErrKeyExists):
+		sess.w.line("NS")
+	case errors.Is(err, storage.ErrKeyNotFound):
+		if cas != 0 {
+			sess.w.line("NF")
+		} else {
+			sess.w.line("NS")
+		}
+	case errors.Is(err, storage.ErrRevisionMismatch):
+		sess.w.line("EX")
+	case err != nil:
+		s.storeError(ctx, sess, "set", err)
+	case !quiet:
+		sess.w.line("HD" + metaReply(args[1], &storage.Entry{Item: i, Revision: revision}, requested))
+	}
+
+	return nil
+}
+
+// metaDelete runs the md command. The flags supported are C (compare cas), k (return key), O (opaque) and
+// q (no reply on success).
+func (s *Server) metaDelete(ctx context.Context, sess *session, args [][]byte) {
+	if len(args) < 2 || !validKey(args[1]) {
+		sess.w.line("CLIENT_ERROR bad command line format")
+		return
+	}
+
+	var quiet bool
+	var cas uint64
+	var requested []string
+	for _, f := range args[2:] {
+		switch f[0] {
+		case 'C':
+			n, err := strconv.ParseUint(string(f[1:]), 10, 64)
+			if err != nil {
+				sess.w.line("CLIENT_ERROR bad token in command line format")
+				return
+			}
+
+			cas = n
+		case 'q':
+			quiet = true
+		case 'k', 'O':
+			requested = append(requested, string(f))
+		default:
+			sess.w.line("CLIENT_ERROR invalid flag")
+			return
+		}
+	}
+
+	// the key is only removed at the revision when a cas is sent
+	e, err := s.Keyspace.Delete(ctx, *sess.token, 0, keygen.Escape(string(args[1])), cas)
+	if errors.Is(err, storage.ErrRevisionMismatch) {
+		sess.w.line("EX")
+		return
+	}
+
+	if err != nil {
+		s.storeError(ctx, sess, "delete", err)
+		return
+	}
+
+	if e == nil {
+		sess.w.line("NF")
+		return
+	}
+
+	if !quiet {
+		sess.w.line("HD" + metaReply(args[1], e, requested))
+	}
+}
@@ -0,0 +1,149 @@
+// Package memcache implements the memcached text protocol, including the meta commands, so memcached
+// clients can use the cache without a rewrite.
+package memcache
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"strconv"
+	"time"
+)
+
+const (
+	// maxKeyLength is the longest key accepted by memcached
+	maxKeyLength = 250
+	// maxValueLength is the largest value accepted from a client, larger values should use the object
+	// store through the cache service
+	maxValueLength = 64 * 1024 * 1024
+	// maxUnauthenticatedLength is the largest data block accepted before the client authenticates so it can
+	// not make the server allocate large buffers, it is large enough for the username and token of the auth
+	// set command
+	maxUnauthenticatedLength = 4 * 1024
+	// maxRelativeExpiration is the largest expiration time treated as a number of seconds from now, larger
+	// values are unix times like memcached
+	maxRelativeExpiration = 60 * 60 * 24 * 30
+)
+
+// clientError is returned when the client sends data that cannot be parsed, the error is sent to the client
+// and the connection is closed since the rest of the stream can not be parsed.
+type clientError string
+
+func (e clientError) Error() string {
+	return string(e)
+}
+
+const (
+	errBadFormat   clientError = "bad command line format"
+	errBadChunk    clientError = "bad data chunk"
+	errLineTooLong clientError = "line too long"
+)
+
+type reader struct {
+	r *bufio.Reader
+}
+
+func newReader(r io.Reader) *reader {
+	return &reader{r: bufio.NewReader(r)}
+}
+
+// readLine returns a copy of the next command line without the line ending, the line is copied since the
+// arguments are still used after the data block is read.
+func (r *reader) readLine() ([]byte, error) {
+	line, err := r.r.ReadSlice('\n')
+	if errors.Is(err, bufio.ErrBufferFull) {
+		return nil, errLineTooLong
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.Clone(bytes.TrimRight(line, "\r\n")), nil
+}
+
+// readData reads the data block of a storage command followed by the line ending.
+func (r *reader) readData(n int) ([]byte, error) {
+	b := make([]byte, n+2)
+	if _, err := io.ReadFull(r.r, b); err != nil {
+		return nil, err
+	}
+
+	if b[n] != '\r' || b[n+1] != '\n' {
+		return nil, errBadChunk
+	}
+
+	return b[:n], nil
+}
+
+type writer struct {
+	w *bufio.Writer
+}
+
+func newWriter(w io.Writer) *writer {
+	return &writer{w: bufio.NewWriter(w)}
+}
+
+func (w *writer) line(s string) {
+	w.w.WriteString(s + "\r\n")
+}
+
+func (w *writer) data(b []byte) {
+	w.w.Write(b)
+	w.w.WriteString("\r\n")
+}
+
+func (w *writer) flush() error {
+	return w.w.Flush()
+}
+
+// validKey reports whether the key can be used by memcached clients, keys cannot contain spaces or
+// control characters.
+func validKey(key []byte) bool {
+	if len(key) == 0 || len(key) > maxKeyLength {
+		return false
+	}
+
+	for _, c := range key {
+		if c <= ' ' || c == 0x7f {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseLength parses the length of a data block of up to limit bytes.
+func parseLength(b []byte, limit int) (int, bool) {
+	n, err := strconv.Atoi(string(b))
+	if err != nil || n < 0 || n > limit {
+		return 0, false
+	}
+
+	return n, true
+}
+
+// expiration converts the expiration time sent by the client to a unix time. 0 never expires, values up to
+// 30 days are seconds from now and larger values are unix times. A negative value is already expired.
+func expiration(exptime int64) int64 {
+	switch {
+	case exptime == 0:
+		return 0
+	case exptime < 0:
+		return time.Now().Unix() - 1
+	case exptime <= maxRelativeExpiration:
+		return time.Now().Unix() + exptime
+	default:
+		return exptime
+	}
+}
+
+// remaining returns the number of seconds until the ttl, -1 if the ttl is not set.
+func remaining(ttl int64) int64 {
+	if ttl == 0 {
+		return -1
+	}
+
+	return max(ttl-time.Now().Unix(), 0)
+}
@@ -0,0 +1,552 @@
+package memcache
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jasonmccallister/nats-cache/internal/auth"
+	"github.com/jasonmccallister/nats-cache/internal/cached"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/keygen"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+// version is returned by the version command, clients use it to detect support for the meta commands
+const version = "1.6.21"
+
+// errNotNumeric is returned when incr or decr is used on a value that is not a decimal number
+var errNotNumeric = errors.New("cannot increment or decrement non-numeric value")
+
+// Server accepts memcached clients and maps their commands onto the keyspace of the cache service. Keys
+// are scoped to a subject the same way as the cache service in database 0, keys the bucket does not accept,
+// such as user:42, are escaped. Writes keep the index of tags and objects in step. Reads refresh a sliding
+// expiration, stale values are returned until they expire and are marked with the X flag by mg. When
+// Subject is set every client uses that subject, otherwise clients authenticate with a set command carrying
+// "<username> <token>" as the value, like the SASL authentication of memcached, and the subject of the token
+// is used.
+type Server struct {
+	Authorizer auth.Authorizer
+	Keyspace   *cached.Keyspace
+	Logger     *slog.Logger
+	Subject    string
+}
+
+// NewServer returns a new server for the memcached protocol. If subject is empty clients must
+// authenticate with a token before using any other command.
+func NewServer(l *slog.Logger, a auth.Authorizer, k *cached.Keyspace, subject string) *Server {
+	return &Server{
+		Logger:     l,
+		Authorizer: a,
+		Keyspace:   k,
+		Subject:    subject,
+	}
+}
+
+// ListenAndServe listens on the address and serves clients until the context is done.
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	return s.Serve(ctx, l)
+}
+
+// Serve serves clients from the listener until the context is done.
+func (s *Server) Serve(ctx context.Context, l net.Listener) error {
+	go func() {
+		<-ctx.Done()
+		l.Close()
+	}()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return err
+		}
+
+		go s.handle(ctx, conn)
+	}
+}
+
+// session is the state of a single client connection.
+type session struct {
+	token *auth.Token
+	r     *reader
+	w     *writer
+}
+
+func (s *Server) handle(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+
+	s.Logger.DebugContext(ctx, "accepted memcached client", "remote", conn.RemoteAddr().String())
+
+	sess := &session{r: newReader(conn), w: newWriter(conn)}
+	if s.Subject != "" {
+		sess.token = &auth.Token{Subject: s.Subject}
+	}
+
+	for {
+		line, err := sess.r.readLine()
+		if err != nil {
+			var ce clientError
+			if errors.As(err, &ce) {
+				sess.w.line("CLIENT_ERROR " + ce.Error())
+				sess.w.flush()
+			}
+
+			if !errors.Is(err, io.EOF) {
+				s.Logger.DebugContext(ctx, "closing memcached client", "remote", conn.RemoteAddr().String(), "error", err.Error())
+			}
+
+			return
+		}
+
+		args := bytes.Fields(line)
+		if len(args) == 0 {
+			sess.w.line("ERROR")
+			sess.w.flush()
+			continue
+		}
+
+		start := time.Now()
+		quit, err := s.exec(ctx, sess, args)
+		if err != nil {
+			var ce clientError
+			if errors.As(err, &ce) {
+				sess.w.line("CLIENT_ERROR " + ce.Error())
+			}
+
+			quit = true
+		}
+
+		s.Logger.DebugContext(ctx, "memcached command", "command", string(args[0]), "duration", time.Since(start).String())
+
+		if err := sess.w.flush(); err != nil || quit {
+			return
+		}
+	}
+}
+
+// exec runs a single command and writes the reply. It returns true if the connection should be closed and
+// an error if the connection can no longer be read.
+func (s *Server) exec(ctx context.Context, sess *session, args [][]byte) (bool, error) {
+	name := string(args[0])
+
+	switch name {
+	case "quit":
+		return true, nil
+	case "version":
+		sess.w.line("VERSION " + version)
+		return false, nil
+	case "mn":
+		sess.w.line("MN")
+		return false, nil
+	}
+
+	if sess.token == nil {
+		if name == "set" {
+			return false, s.auth(sess, args)
+		}
+
+		// the data block of storage commands is read so the next command can be parsed
+		if err := s.discard(sess, name, args); err != nil {
+			return false, err
+		}
+
+		sess.w.line("CLIENT_ERROR unauthenticated")
+		return false, nil
+	}
+
+	switch name {
+	case "get", "gets":
+		s.get(ctx, sess, args)
+	case "set", "add", "replace", "cas":
+		return false, s.store(ctx, sess, name, args)
+	case "delete":
+		s.delete(ctx, sess, args)
+	case "incr", "decr":
+		s.incr(ctx, sess, name, args)
+	case "touch":
+		s.touch(ctx, sess, args)
+	case "mg":
+		s.metaGet(ctx, sess, args)
+	case "ms":
+		return false, s.metaSet(ctx, sess, args)
+	case "md":
+		s.metaDelete(ctx, sess, args)
+	default:
+		sess.w.line("ERROR")
+	}
+
+	return false, nil
+}
+
+// auth authenticates the client with the token sent as the value of a set command.
+func (s *Server) auth(sess *session, args [][]byte) error {
+	if len(args) < 5 {
+		sess.w.line("CLIENT_ERROR bad command line format")
+		return nil
+	}
+
+	n, ok := parseLength(args[4], maxUnauthenticatedLength)
+	if !ok {
+		return errBadFormat
+	}
+
+	data, err := sess.r.readData(n)
+	if err != nil {
+		return err
+	}
+
+	// the value is the username and the token, the username is ignored
+	fields := strings.Fields(string(data))
+	if len(fields) != 2 {
+		sess.w.line("CLIENT_ERROR authentication failure")
+		return nil
+	}
+
+	t, err := s.Authorizer.Authorize("Bearer " + fields[1])
+	if err != nil {
+		s.Logger.Error("failed to authorize memcached client", "error", err.Error())
+		sess.w.line("CLIENT_ERROR authentication failure")
+		return nil
+	}
+
+	sess.token = t
+	sess.w.line("STORED")
+
+	return nil
+}
+
+// discard reads the data block of a storage command sent before the client authenticated.
+func (s *Server) discard(sess *session, name string, args [][]byte) error {
+	var arg int
+	switch name {
+	case "add", "replace", "cas":
+		arg = 4
+	case "ms":
+		arg = 2
+	default:
+		return nil
+	}
+
+	if len(args) <= arg {
+		return nil
+	}
+
+	n, ok := parseLength(args[arg], maxUnauthenticatedLength)
+	if !ok {
+		return errBadFormat
+	}
+
+	_, err := sess.r.readData(n)
+
+	return err
+}
+
+// key returns the internal key of a key sent by the client, keys the bucket does not accept are escaped.
+func (s *Server) key(sess *session, key []byte) string {
+	internalKey, _, _ := keygen.FromToken(*sess.token, 0, keygen.Escape(string(key)))
+
+	return internalKey
+}
+
+func (s *Server) get(ctx context.Context, sess *session, args [][]byte) {
+	if len(args) < 2 {
+		sess.w.line("ERROR")
+		return
+	}
+
+	for _, k := range args[1:] {
+		if !validKey(k) {
+			sess.w.line("CLIENT_ERROR bad command line format")
+			return
+		}
+	}
+
+	for _, k := range args[1:] {
+		e, err := s.Keyspace.Get(ctx, *sess.token, 0, keygen.Escape(string(k)))
+		if err != nil {
+			s.storeError(ctx, sess, "get", err)
+			return
+		}
+
+		// values stored as objects are not returned since they are too large for a reply
+		if e == nil || e.Object != "" {
+			continue
+		}
+
+		header := "VALUE " + string(k) + " " + strconv.FormatUint(uint64(e.Flags), 10) + " " + strconv.Itoa(len(e.Value))
+		if args[0][len(args[0])-1] == 's' {
+			header += " " + strconv.FormatUint(e.Revision, 10)
+		}
+
+		sess.w.line(header)
+		sess.w.data(e.Value)
+	}
+
+	sess.w.line("END")
+}
+
+// store runs the set, add, replace and cas commands. The data block is always read so the next command
+// can be parsed, an error is only returned when the data block cannot be read.
+func (s *Server) store(ctx context.Context, sess *session, name string, args [][]byte) error {
+	want := 5
+	if name == "cas" {
+		want = 6
+	}
+
+	if len(args) < want || len(args) > want+1 {
+		sess.w.line("ERROR")
+		return nil
+	}
+
+	n, ok := parseLength(args[4], maxValueLength)
+	if !ok {
+		return errBadFormat
+	}
+
+	data, err := sess.r.readData(n)
+	if err != nil {
+		return err
+	}
+
+	noreply := len(args) == want+1 && string(args[want]) == "noreply"
+	reply := func(msg string) {
+		if !noreply {
+			sess.w.line(msg)
+		}
+	}
+
+	flags, err := strconv.ParseUint(string(args[2]), 10, 32)
+	if err != nil || !validKey(args[1]) {
+		sess.w.line("CLIENT_ERROR bad command line format")
+		return nil
+	}
+
+	exptime, err := strconv.ParseInt(string(args[3]), 10, 64)
+	if err != nil {
+		sess.w.line("CLIENT_ERROR bad command line format")
+		return nil
+	}
+
+	key := keygen.Escape(string(args[1]))
+	i := storage.Item{Value: data, TTL: expiration(exptime), Flags: uint32(flags)}
+
+	switch name {
+	case "set":
+		_, err = s.Keyspace.Set(ctx, *sess.token, 0, key, i, cachev1.SetCondition_SET_CONDITION_UNSPECIFIED, 0)
+	case "add":
+		_, err = s.Keyspace.Set(ctx, *sess.token, 0, key, i, cachev1.SetCondition_SET_CONDITION_IF_NOT_EXISTS, 0)
+	case "replace":
+		_, err = s.Keyspace.Set(ctx, *sess.token, 0, key, i, cachev1.SetCondition_SET_CONDITION_IF_EXISTS, 0)
+	case "cas":
+		cas, perr := strconv.ParseUint(string(args[5]), 10, 64)
+		if perr != nil {
+			sess.w.line("CLIENT_ERROR bad command line format")
+			return nil
+		}
+
+		_, err = s.compareAndSet(ctx, sess, key, i, cas)
+	}
+
+	switch {
+	case errors.Is(err, storage.ErrKeyExists):
+		reply("NOT_STORED")
+	case errors.Is(err, storage.ErrKeyNotFound):
+		if name == "cas" {
+			reply("NOT_FOUND")
+		} else {
+			reply("NOT_STORED")
+		}
+	case errors.Is(err, storage.ErrRevisionMismatch):
+		reply("EXISTS")
+	case err != nil:
+		s.storeError(ctx, sess, name, err)
+	default:
+		reply("STORED")
+	}
+
+	return nil
+}
+
+// compareAndSet stores the item only if the revision of the key is cas. If the key does not exist or has
+// expired ErrKeyNotFound is returned.
+func (s *Server) compareAndSet(ctx context.Context, sess *session, key string, i storage.Item, cas uint64) (uint64, error) {
+	e, err := s.Keyspace.Store.Get(ctx, s.key(sess, []byte(key)))
+	if err != nil {
+		return 0, err
+	}
+
+	if e == nil {
+		return 0, storage.ErrKeyNotFound
+	}
+
+	if e.Revision != cas {
+		return 0, storage.ErrRevisionMismatch
+	}
+
+	return s.Keyspace.Set(ctx, *sess.token, 0, key, i, cachev1.SetCondition_SET_CONDITION_IF_REVISION, cas)
+}
+
+func (s *Server) delete(ctx context.Context, sess *session, args [][]byte) {
+	// older clients send a time of 0 before noreply
+	if len(args) < 2 || len(args) > 4 {
+		sess.w.line("ERROR")
+		return
+	}
+
+	noreply := string(args[len(args)-1]) == "noreply"
+
+	if !validKey(args[1]) {
+		sess.w.line("CLIENT_ERROR bad command line format")
+		return
+	}
+
+	e, err := s.Keyspace.Delete(ctx, *sess.token, 0, keygen.Escape(string(args[1])), 0)
+	if err != nil {
+		s.storeError(ctx, sess, "delete", err)
+		return
+	}
+
+	if e == nil {
+		if !noreply {
+			sess.w.line("NOT_FOUND")
+		}
+
+		return
+	}
+
+	if !noreply {
+		sess.w.line("DELETED")
+	}
+}
+
+func (s *Server) incr(ctx context.Context, sess *session, name string, args [][]byte) {
+	if len(args) != 3 && len(args) != 4 {
+		sess.w.line("ERROR")
+		return
+	}
+
+	noreply := len(args) == 4 && string(args[3]) == "noreply"
+
+	delta, err := strconv.ParseUint(string(args[2]), 10, 64)
+	if err != nil || !validKey(args[1]) {
+		sess.w.line("CLIENT_ERROR invalid numeric delta argument")
+		return
+	}
+
+	v, err := s.increment(ctx, s.key(sess, args[1]), delta, name == "decr")
+	if errors.Is(err, storage.ErrKeyNotFound) {
+		if !noreply {
+			sess.w.line("NOT_FOUND")
+		}
+
+		return
+	}
+
+	if errors.Is(err, errNotNumeric) {
+		sess.w.line("CLIENT_ERROR " + err.Error())
+		return
+	}
+
+	if err != nil {
+		s.storeError(ctx, sess, name, err)
+		return
+	}
+
+	if !noreply {
+		sess.w.line(strconv.FormatUint(v, 10))
+	}
+}
+
+// increment applies the delta to the unsigned number stored at the key. Unlike Store.Increment the key is
+// not created, an increment wraps around at 64 bits and a decrement stops at 0 like memcached.
+func (s *Server) increment(ctx context.Context, key string, delta uint64, decr bool) (uint64, error) {
+	var value uint64
+	_, err := storage.Mutate(ctx, s.Keyspace.Store, key, func(e *storage.Entry) (storage.Item, error) {
+		if e == nil {
+			return storage.Item{}, storage.ErrKeyNotFound
+		}
+
+		v, err := strconv.ParseUint(string(e.Value), 10, 64)
+		if err != nil {
+			return storage.Item{}, errNotNumeric
+		}
+
+		switch {
+		case !decr:
+			v += delta
+		case delta > v:
+			v = 0
+		default:
+			v -= delta
+		}
+
+		value = v
+
+		i := e.Item
+		i.Value = []byte(strconv.FormatUint(v, 10))
+
+		return i, nil
+	})
+
+	return value, err
+}
+
+func (s *Server) touch(ctx context.Context, sess *session, args [][]byte) {
+	if len(args) != 3 && len(args) != 4 {
+		sess.w.line("ERROR")
+		return
+	}
+
+	noreply := len(args) == 4 && string(args[3]) == "noreply"
+
+	exptime, err := strconv.ParseInt(string(args[2]), 10, 64)
+	if err != nil || !validKey(args[1]) {
+		sess.w.line("CLIENT_ERROR bad command line format")
+		return
+	}
+
+	_, err = s.Keyspace.Store.Touch(ctx, s.key(sess, args[1]), expiration(exptime))
+	if errors.Is(err, storage.ErrKeyNotFound) {
+		if !noreply {
+			sess.w.line("NOT_FOUND")
+		}
+
+		return
+	}
+
+	if err != nil {
+		s.storeError(ctx, sess, "touch", err)
+		return
+	}
+
+	if !noreply {
+		sess.w.line("TOUCHED")
+	}
+}
+
+// storeError logs an error from the store and writes a server error reply.
+func (s *Server) storeError(ctx context.Context, sess *session, op string, err error) {
+	s.Logger.ErrorContext(ctx, "failed to "+op+" key", "error", err.Error())
+
+	if errors.Is(err, storage.ErrValueTooLarge) {
+		sess.w.line("SERVER_ERROR object too large for cache")
+		return
+	}
+
+	sess.w.line("SERVER_ERROR failed to " + op + " key")
+}
@@ -0,0 +1,306 @@
+package memcache
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jasonmccallister/nats-cache/internal/auth"
+	"github.com/jasonmccallister/nats-cache/internal/cached"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+	natsserver "github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// subjectAuthorizer uses the token as the subject and rejects the token "invalid".
+type subjectAuthorizer struct{}
+
+func (subjectAuthorizer) Authorize(token string) (*auth.Token, error) {
+	subject := strings.TrimPrefix(token, "Bearer ")
+	if subject == "invalid" {
+		return nil, fmt.Errorf("invalid token")
+	}
+
+	return &auth.Token{Subject: subject}, nil
+}
+
+type client struct {
+	t    *testing.T
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+func newClient(t *testing.T, s storage.Store, subject string) *client {
+	l := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	server, conn := net.Pipe()
+	srv := NewServer(l, subjectAuthorizer{}, cached.NewKeyspace(l, s, storage.NewInMemoryObjects()), subject)
+	go srv.handle(context.TODO(), server)
+	t.Cleanup(func() { conn.Close() })
+
+	return &client{t: t, conn: conn, r: bufio.NewReader(conn)}
+}
+
+// newNATSStore returns a store using a bucket on an embedded JetStream server, the bucket validates keys
+// the same way as in production.
+func newNATSStore(t *testing.T) storage.Store {
+	t.Helper()
+
+	ns, err := natsserver.NewServer(&natsserver.Options{Port: -1, JetStream: true, StoreDir: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go ns.Start()
+	if !ns.ReadyForConnections(5 * time.Second) {
+		t.Fatal("nats server is not ready")
+	}
+	t.Cleanup(ns.Shutdown)
+
+	nc, err := nats.Connect(ns.ClientURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(nc.Close)
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kv, err := js.CreateKeyValue(context.TODO(), jetstream.KeyValueConfig{Bucket: "cache"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return storage.NewNATSKeyValue(kv, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+// do sends the command and returns the reply lines up to and including the last line of the reply.
+func (c *client) do(cmd string, last ...string) string {
+	c.t.Helper()
+
+	if _, err := c.conn.Write([]byte(cmd)); err != nil {
+		c.t.Fatal(err)
+	}
+
+	var reply string
+	for {
+		line, err := c.r.ReadString('\n')
+		if err != nil {
+			c.t.Fatal(err)
+		}
+
+		reply += line
+
+		if len(last) == 0 {
+			return reply
+		}
+
+		for _, l := range last {
+			if strings.HasPrefix(line, l) {
+				return reply
+			}
+		}
+	}
+}
+
+func TestServer(t *testing.T) {
+	s := storage.NewInMemory()
+	c := newClient(t, s, "")
+
+	tests := []struct {
+		cmd  string
+		last []string
+		want string
+	}{
+		{cmd: "version\r\n", want: "VERSION 1.6.21\r\n"},
+		{cmd: "get a\r\n", want: "CLIENT_ERROR unauthenticated\r\n"},
+		{cmd: "add a 0 0 1\r\n1\r\n", want: "CLIENT_ERROR unauthenticated\r\n"},
+		{cmd: "set auth 0 0 12\r\nuser invalid\r\n", want: "CLIENT_ERROR authentication failure\r\n"},
+		{cmd: "set auth 0 0 10\r\nuser alice\r\n", want: "STORED\r\n"},
+		{cmd: "get a\r\n", want: "END\r\n"},
+		{cmd: "set a 42 0 1\r\n1\r\n", want: "STORED\r\n"},
+		{cmd: "get a missing\r\n", last: []string{"END"}, want: "VALUE a 42 1\r\n1\r\nEND\r\n"},
+		{cmd: "add a 0 0 1\r\n2\r\n", want: "NOT_STORED\r\n"},
+		{cmd: "replace b 0 0 1\r\n2\r\n", want: "NOT_STORED\r\n"},
+		{cmd: "add b 0 100 1\r\n2\r\n", want: "STORED\r\n"},
+		{cmd: "replace b 7 0 1\r\n3\r\n", want: "STORED\r\n"},
+		{cmd: "gets b\r\n", last: []string{"END"}, want: "VALUE b 7 1 3\r\n3\r\nEND\r\n"},
+		{cmd: "cas b 0 0 1 2\r\n4\r\n", want: "EXISTS\r\n"},
+		{cmd: "cas b 0 0 1 3\r\n4\r\n", want: "STORED\r\n"},
+		{cmd: "cas missing 0 0 1 3\r\n4\r\n", want: "NOT_FOUND\r\n"},
+		{cmd: "incr a 10\r\n", want: "11\r\n"},
+		{cmd: "decr a 20\r\n", want: "0\r\n"},
+		{cmd: "incr missing 1\r\n", want: "NOT_FOUND\r\n"},
+		{cmd: "set c 0 0 3\r\nabc\r\n", want: "STORED\r\n"},
+		{cmd: "incr c 1\r\n", want: "CLIENT_ERROR cannot increment or decrement non-numeric value\r\n"},
+		{cmd: "touch c 100\r\n", want: "TOUCHED\r\n"},
+		{cmd: "touch missing 100\r\n", want: "NOT_FOUND\r\n"},
+		{cmd: "delete c\r\n", want: "DELETED\r\n"},
+		{cmd: "delete c\r\n", want: "NOT_FOUND\r\n"},
+		{cmd: "set d 0 -1 1\r\n1\r\n", want: "STORED\r\n"},
+		{cmd: "get d\r\n", want: "END\r\n"},
+		{cmd: "set e 0 0 1 noreply\r\n5\r\nget e\r\n", last: []string{"END"}, want: "VALUE e 0 1\r\n5\r\nEND\r\n"},
+		{cmd: "mg a v f t k\r\n", last: []string{"0"}, want: "VA 1 f42 t-1 ka\r\n0\r\n"},
+		{cmd: "mg b s c Oxyz\r\n", want: "HD s1 c4 Oxyz\r\n"},
+		{cmd: "mg missing v\r\n", want: "EN\r\n"},
+		{cmd: "mg missing v q\r\nmn\r\n", want: "MN\r\n"},
+		{cmd: "mg b T100 t\r\n", want: "HD t100\r\n"},
+		{cmd: "ms f 2 F3 T100 c\r\nhi\r\n", want: "HD c13\r\n"},
+		{cmd: "ms f 2 ME\r\nhi\r\n", want: "NS\r\n"},
+		{cmd: "ms g 2 MR\r\nhi\r\n", want: "NS\r\n"},
+		{cmd: "ms f 2 C1\r\nhi\r\n", want: "EX\r\n"},
+		{cmd: "ms f 3 C13 q\r\nbye\r\nmn\r\n", want: "MN\r\n"},
+		{cmd: "ms f 2 MA\r\nhi\r\n", want: "CLIENT_ERROR invalid mode for ms\r\n"},
+		{cmd: "md f C1\r\n", want: "EX\r\n"},
+		{cmd: "md f C14 k\r\n", want: "HD kf\r\n"},
+		{cmd: "md f\r\n", want: "NF\r\n"},
+		{cmd: "nope\r\n", want: "ERROR\r\n"},
+	}
+	for _, tt := range tests {
+		if got := c.do(tt.cmd, tt.last...); got != tt.want {
+			t.Errorf("%q = %q, want %q", tt.cmd, got, tt.want)
+		}
+	}
+
+	// the keys are scoped to the subject like the cache service
+	e, err := s.Get(context.TODO(), "alice.0-a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e == nil || string(e.Value) != "0" || e.Flags != 42 {
+		t.Errorf("Get() = %v, want 0 with flags 42", e)
+	}
+
+	other := newClient(t, s, "bob")
+	if got := other.do("get a\r\n"); got != "END\r\n" {
+		t.Errorf("get a for another subject = %q, want a miss", got)
+	}
+}
+
+func TestServer_escapedKeys(t *testing.T) {
+	s := newNATSStore(t)
+	c := newClient(t, s, "alice")
+
+	tests := []struct {
+		cmd  string
+		last []string
+		want string
+	}{
+		{cmd: "set user:42 0 0 1\r\n1\r\n", want: "STORED\r\n"},
+		{cmd: "set user.43 0 0 1\r\n2\r\n", want: "STORED\r\n"},
+		{cmd: "get user:42 user.43\r\n", last: []string{"END"}, want: "VALUE user:42 0 1\r\n1\r\nVALUE user.43 0 1\r\n2\r\nEND\r\n"},
+		{cmd: "add a.* 0 0 1\r\n3\r\n", want: "STORED\r\n"},
+		{cmd: "incr user:42 1\r\n", want: "2\r\n"},
+		{cmd: "touch user:42 100\r\n", want: "TOUCHED\r\n"},
+		{cmd: "mg user:42 v t k\r\n", last: []string{"2"}, want: "VA 1 t100 kuser:42\r\n2\r\n"},
+		{cmd: "ms a.. 2\r\nhi\r\n", want: "HD\r\n"},
+		{cmd: "md a..\r\n", want: "HD\r\n"},
+		{cmd: "delete user:42\r\n", want: "DELETED\r\n"},
+		{cmd: "get user:42 a.*\r\n", last: []string{"END"}, want: "VALUE a.* 0 1\r\n3\r\nEND\r\n"},
+	}
+	for _, tt := range tests {
+		if got := c.do(tt.cmd, tt.last...); got != tt.want {
+			t.Errorf("%q = %q, want %q", tt.cmd, got, tt.want)
+		}
+	}
+
+	// keys the bucket accepts are shared with the cache service
+	e, err := s.Get(context.TODO(), "alice.0-user.43")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e == nil || string(e.Value) != "2" {
+		t.Errorf("Get() = %v, want 2", e)
+	}
+}
+
+func TestServer_keyspace(t *testing.T) {
+	s := storage.NewInMemory()
+	c := newClient(t, s, "alice")
+
+	// written by the cache service with a tag
+	if _, err := s.Set(context.TODO(), "alice.0-a", storage.Item{Value: []byte("1"), Tags: []string{"users"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := storage.TagAdd(context.TODO(), s, "alice.0.tag-dXNlcnM", "alice.0-a"); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := c.do("delete a\r\n"); got != "DELETED\r\n" {
+		t.Fatalf("delete a = %q, want DELETED", got)
+	}
+
+	index, _, err := storage.SetGet(context.TODO(), s, "alice.0.tag-dXNlcnM")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(index) != 0 {
+		t.Errorf("tag index = %v, want the key removed", index.Members())
+	}
+
+	// stale values are returned and marked
+	now := time.Now().Unix()
+	if _, err := s.Set(context.TODO(), "alice.0-b", storage.Item{Value: []byte("2"), TTL: now + 100, SoftTTL: now - 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := c.do("mg b v\r\n", "2"); got != "VA 1 X\r\n2\r\n" {
+		t.Errorf("mg b v = %q, want a stale value", got)
+	}
+}
+
+func TestServer_unauthenticatedLimits(t *testing.T) {
+	tests := []struct {
+		name string
+		cmd  string
+	}{
+		{name: "should refuse a large auth value", cmd: "set auth 0 0 67108864\r\n"},
+		{name: "should refuse a large data block", cmd: "add a 0 0 67108864\r\n"},
+		{name: "should refuse a large meta data block", cmd: "ms a 67108864\r\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClient(t, storage.NewInMemory(), "")
+
+			if got := c.do(tt.cmd); got != "CLIENT_ERROR bad command line format\r\n" {
+				t.Errorf("%q = %q, want a client error", tt.cmd, got)
+			}
+		})
+	}
+
+	// the limit is lifted once the client is authenticated
+	c := newClient(t, storage.NewInMemory(), "")
+	c.do("set auth 0 0 10\r\nuser alice\r\n")
+
+	value := strings.Repeat("a", 64*1024)
+	if got := c.do(fmt.Sprintf("set a 0 0 %d\r\n%s\r\n", len(value), value)); got != "STORED\r\n" {
+		t.Errorf("set a with a large value = %q, want STORED", got)
+	}
+}
+
+func TestServer_badDataChunk(t *testing.T) {
+	c := newClient(t, storage.NewInMemory(), "alice")
+
+	if got := c.do("set a 0 0 1\r\n123\r\n"); got != "CLIENT_ERROR bad data chunk\r\n" {
+		t.Errorf("set with a long data block = %q, want a client error", got)
+	}
+}
+
+func TestExpiration(t *testing.T) {
+	if got := expiration(0); got != 0 {
+		t.Errorf("expiration(0) = %d, want 0", got)
+	}
+
+	if got := expiration(maxRelativeExpiration + 1); got != maxRelativeExpiration+1 {
+		t.Errorf("expiration() of a unix time = %d, want the unix time", got)
+	}
+}
@@ -0,0 +1,226 @@
+// Package natsservice exposes the cache service as a NATS micro service so NATS clients can use the cache
+// with request and reply instead of HTTP. The service is discoverable with the $SRV subjects.
+package natsservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jasonmccallister/nats-cache/internal/gen/cache/v1/cachev1connect"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/micro"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// Name is the name of the service used for discovery
+	Name = "nats-cache"
+	// Version is the version of the service reported by discovery
+	Version = "1.0.0"
+	// Prefix is the subject prefix of every endpoint, such as cache.v1.get
+	Prefix = "cache.v1"
+	// ContentTypeProto is the content type of requests encoded as binary protobuf, requests without the
+	// content type are JSON encoded protobuf messages
+	ContentTypeProto = "application/protobuf"
+	// ContentTypeJSON is the content type of JSON encoded requests and replies
+	ContentTypeJSON = "application/json"
+	// TimeoutHeader is the header with the number of milliseconds a request may take, requests without
+	// the header use DefaultTimeout
+	TimeoutHeader = "Nats-Cache-Timeout"
+	// DefaultTimeout is how long a request may take if it does not set the TimeoutHeader
+	DefaultTimeout = 30 * time.Second
+	// MaxConcurrentRequests is the number of requests handled at the same time across every endpoint,
+	// further requests wait for a request to finish
+	MaxConcurrentRequests = 256
+)
+
+// message is a pointer to a protobuf message.
+type message[T any] interface {
+	*T
+	proto.Message
+}
+
+// endpoint creates a handler that decodes the request, calls the method of the cache service with the
+// Authorization header of the request and encodes the reply in the same format as the request.
+// Requests are handled in their own goroutine since methods such as LPop can wait and requests to an
+// endpoint are otherwise handled one at a time. The goroutines are limited by the semaphore shared by
+// every endpoint, once it is full the endpoint stops receiving requests until a request finishes.
+func endpoint[Req, Res any, PReq message[Req], PRes message[Res]](ctx context.Context, l *slog.Logger, sem chan struct{}, fn func(context.Context, *connect.Request[Req]) (*connect.Response[Res], error)) micro.Handler {
+	return micro.ContextHandler(ctx, func(ctx context.Context, r micro.Request) {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			respondError(r, connect.NewError(connect.CodeUnavailable, ctx.Err()))
+			return
+		}
+
+		go func() {
+			defer func() { <-sem }()
+
+			handle[Req, Res, PReq, PRes](ctx, l, r, fn)
+		}()
+	})
+}
+
+func handle[Req, Res any, PReq message[Req], PRes message[Res]](ctx context.Context, l *slog.Logger, r micro.Request, fn func(context.Context, *connect.Request[Req]) (*connect.Response[Res], error)) {
+	// the requester stops waiting for the reply at some point so the request is not handled forever
+	timeout := DefaultTimeout
+	if v := r.Headers().Get(TimeoutHeader); v != "" {
+		ms, err := strconv.ParseUint(v, 10, 32)
+		if err != nil || ms == 0 {
+			respondError(r, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid %s header: %q", TimeoutHeader, v)))
+			return
+		}
+
+		timeout = time.Duration(ms) * time.Millisecond
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	binary := r.Headers().Get("Content-Type") == ContentTypeProto
+
+	var msg PReq = new(Req)
+
+	var err error
+	if binary {
+		err = proto.Unmarshal(r.Data(), msg)
+	} else if len(r.Data()) > 0 {
+		err = protojson.Unmarshal(r.Data(), msg)
+	}
+	if err != nil {
+		respondError(r, connect.NewError(connect.CodeInvalidArgument, err))
+		return
+	}
+
+	req := connect.NewRequest((*Req)(msg))
+	req.Header().Set("Authorization", r.Headers().Get("Authorization"))
+
+	start := time.Now()
+	resp, err := fn(ctx, req)
+	if err != nil {
+		respondError(r, err)
+		return
+	}
+
+	l.DebugContext(ctx, "nats request", "subject", r.Subject(), "duration", time.Since(start).String())
+
+	var out PRes = resp.Msg
+
+	var b []byte
+	contentType := ContentTypeJSON
+	if binary {
+		b, err = proto.Marshal(out)
+		contentType = ContentTypeProto
+	} else {
+		b, err = protojson.Marshal(out)
+	}
+	if err != nil {
+		l.ErrorContext(ctx, "failed to encode reply", "subject", r.Subject(), "error", err.Error())
+		respondError(r, connect.NewError(connect.CodeInternal, err))
+		return
+	}
+
+	if err := r.Respond(b, micro.WithHeaders(micro.Headers{"Content-Type": []string{contentType}})); err != nil {
+		l.ErrorContext(ctx, "failed to respond", "subject", r.Subject(), "error", err.Error())
+	}
+}
+
+// respondError replies with the connect error code, such as not_found, and the error message so clients
+// handle errors the same way as with the cache service.
+func respondError(r micro.Request, err error) {
+	msg := err.Error()
+
+	var ce *connect.Error
+	if errors.As(err, &ce) {
+		msg = ce.Message()
+	}
+
+	r.Error(connect.CodeOf(err).String(), msg, nil)
+}
+
+// New adds the service to the connection with an endpoint for every unary method of the cache service. The
+// streaming methods are only available over HTTP. The service is stopped when the context is done.
+func New(ctx context.Context, nc *nats.Conn, l *slog.Logger, svc cachev1connect.CacheServiceHandler) (micro.Service, error) {
+	srv, err := micro.AddService(nc, micro.Config{
+		Name:        Name,
+		Version:     Version,
+		Description: "Cache operations over NATS request and reply",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	g := srv.AddGroup(Prefix)
+
+	sem := make(chan struct{}, MaxConcurrentRequests)
+
+	endpoints := map[string]micro.Handler{
+		"decrement":       endpoint(ctx, l, sem, svc.Decrement),
+		"delete":          endpoint(ctx, l, sem, svc.Delete),
+		"delete_multi":    endpoint(ctx, l, sem, svc.DeleteMulti),
+		"exists":          endpoint(ctx, l, sem, svc.Exists),
+		"expire_at":       endpoint(ctx, l, sem, svc.ExpireAt),
+		"fill":            endpoint(ctx, l, sem, svc.Fill),
+		"get":             endpoint(ctx, l, sem, svc.Get),
+		"get_and_delete":  endpoint(ctx, l, sem, svc.GetAndDelete),
+		"get_and_set":     endpoint(ctx, l, sem, svc.GetAndSet),
+		"get_metadata":    endpoint(ctx, l, sem, svc.GetMetadata),
+		"get_multi":       endpoint(ctx, l, sem, svc.GetMulti),
+		"get_or_lease":    endpoint(ctx, l, sem, svc.GetOrLease),
+		"hdel":            endpoint(ctx, l, sem, svc.HDel),
+		"hget":            endpoint(ctx, l, sem, svc.HGet),
+		"hgetall":         endpoint(ctx, l, sem, svc.HGetAll),
+		"hincrby":         endpoint(ctx, l, sem, svc.HIncrBy),
+		"hmget":           endpoint(ctx, l, sem, svc.HMGet),
+		"hset":            endpoint(ctx, l, sem, svc.HSet),
+		"history":         endpoint(ctx, l, sem, svc.History),
+		"increment":       endpoint(ctx, l, sem, svc.Increment),
+		"invalidate_tags": endpoint(ctx, l, sem, svc.InvalidateTags),
+		"list_keys":       endpoint(ctx, l, sem, svc.ListKeys),
+		"llen":            endpoint(ctx, l, sem, svc.LLen),
+		"lpop":            endpoint(ctx, l, sem, svc.LPop),
+		"lpush":           endpoint(ctx, l, sem, svc.LPush),
+		"lrange":          endpoint(ctx, l, sem, svc.LRange),
+		"ltrim":           endpoint(ctx, l, sem, svc.LTrim),
+		"persist":         endpoint(ctx, l, sem, svc.Persist),
+		"purge":           endpoint(ctx, l, sem, svc.Purge),
+		"rate_limit":      endpoint(ctx, l, sem, svc.RateLimit),
+		"revert":          endpoint(ctx, l, sem, svc.Revert),
+		"rpop":            endpoint(ctx, l, sem, svc.RPop),
+		"rpush":           endpoint(ctx, l, sem, svc.RPush),
+		"sadd":            endpoint(ctx, l, sem, svc.SAdd),
+		"scard":           endpoint(ctx, l, sem, svc.SCard),
+		"set":             endpoint(ctx, l, sem, svc.Set),
+		"set_multi":       endpoint(ctx, l, sem, svc.SetMulti),
+		"sismember":       endpoint(ctx, l, sem, svc.SIsMember),
+		"smembers":        endpoint(ctx, l, sem, svc.SMembers),
+		"srem":            endpoint(ctx, l, sem, svc.SRem),
+		"touch":           endpoint(ctx, l, sem, svc.Touch),
+		"ttl":             endpoint(ctx, l, sem, svc.TTL),
+		"zadd":            endpoint(ctx, l, sem, svc.ZAdd),
+		"zincrby":         endpoint(ctx, l, sem, svc.ZIncrBy),
+		"zrange":          endpoint(ctx, l, sem, svc.ZRange),
+		"zrank":           endpoint(ctx, l, sem, svc.ZRank),
+	}
+
+	for name, h := range endpoints {
+		if err := g.AddEndpoint(name, h); err != nil {
+			srv.Stop()
+			return nil, err
+		}
+	}
+
+	go func() {
+		<-ctx.Done()
+		srv.Stop()
+	}()
+
+	return srv, nil
+}
@@ -0,0 +1,206 @@
+package natsservice
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jasonmccallister/nats-cache/internal/auth"
+	"github.com/jasonmccallister/nats-cache/internal/cached"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+	natsserver "github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/micro"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// subjectAuthorizer uses the token as the subject and rejects the token "invalid".
+type subjectAuthorizer struct{}
+
+func (subjectAuthorizer) Authorize(token string) (*auth.Token, error) {
+	subject := strings.TrimPrefix(token, "Bearer ")
+	if subject == "" || subject == "invalid" {
+		return nil, fmt.Errorf("invalid token")
+	}
+
+	return &auth.Token{Subject: subject}, nil
+}
+
+func newConn(t *testing.T) *nats.Conn {
+	t.Helper()
+
+	ns, err := natsserver.NewServer(&natsserver.Options{Port: -1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go ns.Start()
+	if !ns.ReadyForConnections(5 * time.Second) {
+		t.Fatal("nats server is not ready")
+	}
+	t.Cleanup(ns.Shutdown)
+
+	nc, err := nats.Connect(ns.ClientURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(nc.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	l := slog.New(slog.NewTextHandler(io.Discard, nil))
+	if _, err := New(ctx, nc, l, cached.NewServer(l, subjectAuthorizer{}, storage.NewInMemory(), storage.NewInMemoryObjects())); err != nil {
+		t.Fatal(err)
+	}
+
+	return nc
+}
+
+func request(t *testing.T, nc *nats.Conn, subject, token string, data []byte, contentType string) *nats.Msg {
+	t.Helper()
+
+	msg := nats.NewMsg(subject)
+	msg.Data = data
+	msg.Header.Set("Authorization", "Bearer "+token)
+	if contentType != "" {
+		msg.Header.Set("Content-Type", contentType)
+	}
+
+	reply, err := nc.RequestMsg(msg, 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return reply
+}
+
+func TestService(t *testing.T) {
+	nc := newConn(t)
+
+	reply := request(t, nc, "cache.v1.set", "alice", []byte(`{"key":"a","value":"aGVsbG8=","ttl":100}`), "")
+	if code := reply.Header.Get(micro.ErrorCodeHeader); code != "" {
+		t.Fatalf("set returned %s: %s", code, reply.Header.Get(micro.ErrorHeader))
+	}
+
+	var set cachev1.SetResponse
+	if err := protojson.Unmarshal(reply.Data, &set); err != nil {
+		t.Fatal(err)
+	}
+	if set.GetRevision() == 0 {
+		t.Error("set did not return a revision")
+	}
+
+	// binary protobuf requests get binary replies
+	b, err := proto.Marshal(&cachev1.GetRequest{Key: "a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reply = request(t, nc, "cache.v1.get", "alice", b, ContentTypeProto)
+	if got := reply.Header.Get("Content-Type"); got != ContentTypeProto {
+		t.Errorf("get Content-Type = %q, want %q", got, ContentTypeProto)
+	}
+
+	var get cachev1.GetResponse
+	if err := proto.Unmarshal(reply.Data, &get); err != nil {
+		t.Fatal(err)
+	}
+	if string(get.GetValue()) != "hello" || get.GetRevision() != set.GetRevision() {
+		t.Errorf("get = %v, want hello at revision %d", get.String(), set.GetRevision())
+	}
+
+	// keys are scoped to the subject of the token
+	reply = request(t, nc, "cache.v1.get", "bob", []byte(`{"key":"a"}`), "")
+	get.Reset()
+	if err := protojson.Unmarshal(reply.Data, &get); err != nil {
+		t.Fatal(err)
+	}
+	if len(get.GetValue()) != 0 {
+		t.Errorf("get for another subject = %q, want no value", get.GetValue())
+	}
+}
+
+func TestService_errors(t *testing.T) {
+	nc := newConn(t)
+
+	tests := []struct {
+		name    string
+		subject string
+		token   string
+		data    string
+		want    string
+	}{
+		{name: "invalid token", subject: "cache.v1.get", token: "invalid", data: `{"key":"a"}`, want: "unauthenticated"},
+		{name: "invalid request", subject: "cache.v1.get", token: "alice", data: `{"key":`, want: "invalid_argument"},
+		{name: "handler error", subject: "cache.v1.set", token: "alice", data: `{"key":"a","ttl":1,"soft_ttl":2}`, want: "invalid_argument"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reply := request(t, nc, tt.subject, tt.token, []byte(tt.data), "")
+			if got := reply.Header.Get(micro.ErrorCodeHeader); got != tt.want {
+				t.Errorf("error code = %q, want %q: %s", got, tt.want, reply.Header.Get(micro.ErrorHeader))
+			}
+		})
+	}
+}
+
+func TestService_discovery(t *testing.T) {
+	nc := newConn(t)
+
+	reply, err := nc.Request("$SRV.INFO."+Name, nil, 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(reply.Data), `"subject":"cache.v1.get"`) {
+		t.Errorf("info does not list cache.v1.get: %s", reply.Data)
+	}
+}
+
+func TestService_timeout(t *testing.T) {
+	nc := newConn(t)
+
+	reply := request(t, nc, "cache.v1.get_or_lease", "alice", []byte(`{"key":"a","lease_ttl":100}`), "")
+	if code := reply.Header.Get(micro.ErrorCodeHeader); code != "" {
+		t.Fatalf("get_or_lease returned %s: %s", code, reply.Header.Get(micro.ErrorHeader))
+	}
+
+	tests := []struct {
+		name    string
+		timeout string
+		want    string
+	}{
+		{name: "deadline", timeout: "100", want: "deadline_exceeded"},
+		{name: "invalid", timeout: "soon", want: "invalid_argument"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the lease is held so the request waits until the timeout of the request
+			msg := nats.NewMsg("cache.v1.get_or_lease")
+			msg.Data = []byte(`{"key":"a","wait":10000}`)
+			msg.Header.Set("Authorization", "Bearer alice")
+			msg.Header.Set(TimeoutHeader, tt.timeout)
+
+			start := time.Now()
+			reply, err := nc.RequestMsg(msg, 5*time.Second)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := reply.Header.Get(micro.ErrorCodeHeader); got != tt.want {
+				t.Errorf("error code = %q, want %q: %s", got, tt.want, reply.Header.Get(micro.ErrorHeader))
+			}
+
+			if time.Since(start) > time.Second {
+				t.Errorf("request took %s, want the timeout of the request", time.Since(start))
+			}
+		})
+	}
+}
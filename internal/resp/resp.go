@@ -0,0 +1,184 @@
+// Package resp implements the Redis serialization protocol (RESP2 and RESP3) so Redis clients can use
+// the cache without a rewrite.
+package resp
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+const (
+	// maxBulkLength is the largest bulk string accepted from a client, larger values should use the
+	// object store through the cache service
+	maxBulkLength = 64 * 1024 * 1024
+	// maxArrayLength is the largest number of arguments accepted in a single command
+	maxArrayLength = 1024 * 1024
+	// maxUnauthenticatedBulkLength and maxUnauthenticatedArrayLength limit the commands of a client that
+	// has not authenticated, like Redis, so it can not make the server allocate large buffers. They are
+	// large enough for AUTH and HELLO with a token.
+	maxUnauthenticatedBulkLength  = 4 * 1024
+	maxUnauthenticatedArrayLength = 10
+)
+
+// errProtocol is returned when the client sends data that is not valid RESP, the connection is closed
+// since the rest of the stream can not be parsed.
+var errProtocol = errors.New("protocol error")
+
+type reader struct {
+	r *bufio.Reader
+}
+
+func newReader(r io.Reader) *reader {
+	return &reader{r: bufio.NewReader(r)}
+}
+
+// readCommand reads the next command as a list of arguments. Commands are normally sent as an array
+// of bulk strings but inline commands separated by spaces are also accepted for clients like telnet.
+// Until the client is authenticated only small commands are accepted.
+func (r *reader) readCommand(authenticated bool) ([][]byte, error) {
+	maxArray, maxBulk := maxArrayLength, maxBulkLength
+	if !authenticated {
+		maxArray, maxBulk = maxUnauthenticatedArrayLength, maxUnauthenticatedBulkLength
+	}
+
+	for {
+		line, err := r.readLine()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(line) == 0 {
+			continue
+		}
+
+		if line[0] != '*' {
+			return bytes.Fields(line), nil
+		}
+
+		n, err := strconv.Atoi(string(line[1:]))
+		if err != nil || n > maxArray {
+			return nil, fmt.Errorf("%w: invalid multibulk length", errProtocol)
+		}
+
+		if n <= 0 {
+			continue
+		}
+
+		args := make([][]byte, n)
+		for i := range args {
+			args[i], err = r.readBulk(maxBulk)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return args, nil
+	}
+}
+
+// readBulk reads a bulk string of up to limit bytes.
+func (r *reader) readBulk(limit int) ([]byte, error) {
+	line, err := r.readLine()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(line) == 0 || line[0] != '$' {
+		return nil, fmt.Errorf("%w: expected '$', got '%s'", errProtocol, line)
+	}
+
+	n, err := strconv.Atoi(string(line[1:]))
+	if err != nil || n < 0 || n > limit {
+		return nil, fmt.Errorf("%w: invalid bulk length", errProtocol)
+	}
+
+	b := make([]byte, n+2)
+	if _, err := io.ReadFull(r.r, b); err != nil {
+		return nil, err
+	}
+
+	if b[n] != '\r' || b[n+1] != '\n' {
+		return nil, fmt.Errorf("%w: bulk string is not terminated", errProtocol)
+	}
+
+	return b[:n], nil
+}
+
+// readLine returns the next line without the line ending.
+func (r *reader) readLine() ([]byte, error) {
+	line, err := r.r.ReadSlice('\n')
+	if errors.Is(err, bufio.ErrBufferFull) {
+		return nil, fmt.Errorf("%w: line is too long", errProtocol)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimRight(line, "\r\n"), nil
+}
+
+// writer writes replies using the protocol version selected by the client with HELLO.
+type writer struct {
+	w     *bufio.Writer
+	proto int
+}
+
+func newWriter(w io.Writer) *writer {
+	return &writer{w: bufio.NewWriter(w), proto: 2}
+}
+
+func (w *writer) simple(s string) {
+	w.w.WriteString("+" + s + "\r\n")
+}
+
+// error writes an error reply, the message should start with an error code such as ERR.
+func (w *writer) error(msg string) {
+	w.w.WriteString("-" + msg + "\r\n")
+}
+
+func (w *writer) integer(n int64) {
+	w.w.WriteString(":" + strconv.FormatInt(n, 10) + "\r\n")
+}
+
+func (w *writer) bulk(b []byte) {
+	w.w.WriteString("$" + strconv.Itoa(len(b)) + "\r\n")
+	w.w.Write(b)
+	w.w.WriteString("\r\n")
+}
+
+func (w *writer) bulkString(s string) {
+	w.bulk([]byte(s))
+}
+
+// null writes a missing value, RESP3 has a dedicated null type.
+func (w *writer) null() {
+	if w.proto == 3 {
+		w.w.WriteString("_\r\n")
+		return
+	}
+
+	w.w.WriteString("$-1\r\n")
+}
+
+func (w *writer) array(n int) {
+	w.w.WriteString("*" + strconv.Itoa(n) + "\r\n")
+}
+
+// mapHeader writes the header of a map with n pairs, RESP2 has no map type so a flat array is used.
+func (w *writer) mapHeader(n int) {
+	if w.proto == 3 {
+		w.w.WriteString("%" + strconv.Itoa(n) + "\r\n")
+		return
+	}
+
+	w.array(n * 2)
+}
+
+func (w *writer) flush() error {
+	return w.w.Flush()
+}
@@ -0,0 +1,715 @@
+package resp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jasonmccallister/nats-cache/internal/auth"
+	"github.com/jasonmccallister/nats-cache/internal/cached"
+	cachev1 "github.com/jasonmccallister/nats-cache/internal/gen/cache/v1"
+	"github.com/jasonmccallister/nats-cache/internal/keygen"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+)
+
+// defaultScanCount is the number of keys checked by SCAN if the command does not provide a count
+const defaultScanCount = 10
+
+// Server accepts Redis clients and maps their commands onto the keyspace of the cache service. Keys are
+// scoped to the subject of the token sent with AUTH and the database selected with SELECT the same way as
+// the cache service, keys the bucket does not accept, such as user:42, are escaped. Writes keep the index
+// of tags and objects in step and reads push a sliding expiration forward, values past their soft ttl are
+// returned until they expire since Redis has no notion of stale values.
+type Server struct {
+	Authorizer auth.Authorizer
+	Keyspace   *cached.Keyspace
+	Logger     *slog.Logger
+}
+
+// NewServer returns a new server for the Redis protocol.
+func NewServer(l *slog.Logger, a auth.Authorizer, k *cached.Keyspace) *Server {
+	return &Server{
+		Logger:     l,
+		Authorizer: a,
+		Keyspace:   k,
+	}
+}
+
+// ListenAndServe listens on the address and serves clients until the context is done.
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	return s.Serve(ctx, l)
+}
+
+// Serve serves clients from the listener until the context is done.
+func (s *Server) Serve(ctx context.Context, l net.Listener) error {
+	go func() {
+		<-ctx.Done()
+		l.Close()
+	}()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return err
+		}
+
+		go s.handle(ctx, conn)
+	}
+}
+
+// session is the state of a single client connection.
+type session struct {
+	token *auth.Token
+	db    uint32
+}
+
+func (s *Server) handle(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+
+	s.Logger.DebugContext(ctx, "accepted redis client", "remote", conn.RemoteAddr().String())
+
+	r := newReader(conn)
+	w := newWriter(conn)
+	sess := &session{}
+
+	for {
+		args, err := r.readCommand(sess.token != nil)
+		if err != nil {
+			if errors.Is(err, errProtocol) {
+				w.error("ERR " + err.Error())
+				w.flush()
+			}
+
+			if !errors.Is(err, io.EOF) {
+				s.Logger.DebugContext(ctx, "closing redis client", "remote", conn.RemoteAddr().String(), "error", err.Error())
+			}
+
+			return
+		}
+
+		if len(args) == 0 {
+			continue
+		}
+
+		start := time.Now()
+		quit := s.exec(ctx, sess, w, args)
+
+		s.Logger.DebugContext(ctx, "redis command", "command", strings.ToLower(string(args[0])), "duration", time.Since(start).String())
+
+		if err := w.flush(); err != nil || quit {
+			return
+		}
+	}
+}
+
+// exec runs a single command and writes the reply, it returns true if the connection should be closed.
+func (s *Server) exec(ctx context.Context, sess *session, w *writer, args [][]byte) bool {
+	name := strings.ToUpper(string(args[0]))
+
+	switch name {
+	case "PING":
+		switch len(args) {
+		case 1:
+			w.simple("PONG")
+		case 2:
+			w.bulk(args[1])
+		default:
+			wrongArgs(w, name)
+		}
+
+		return false
+	case "ECHO":
+		if len(args) != 2 {
+			wrongArgs(w, name)
+			return false
+		}
+
+		w.bulk(args[1])
+
+		return false
+	case "QUIT":
+		w.simple("OK")
+
+		return true
+	case "HELLO":
+		s.hello(sess, w, args)
+
+		return false
+	case "AUTH":
+		// AUTH takes the token as the password with an optional username that is ignored
+		if len(args) != 2 && len(args) != 3 {
+			wrongArgs(w, name)
+			return false
+		}
+
+		if s.auth(sess, w, string(args[len(args)-1])) {
+			w.simple("OK")
+		}
+
+		return false
+	case "SELECT":
+		if len(args) != 2 {
+			wrongArgs(w, name)
+			return false
+		}
+
+		db, err := strconv.ParseUint(string(args[1]), 10, 32)
+		if err != nil {
+			w.error("ERR DB index is out of range")
+			return false
+		}
+
+		sess.db = uint32(db)
+		w.simple("OK")
+
+		return false
+	}
+
+	if sess.token == nil {
+		w.error("NOAUTH Authentication required.")
+		return false
+	}
+
+	switch name {
+	case "GET":
+		s.get(ctx, sess, w, args)
+	case "SET":
+		s.set(ctx, sess, w, args)
+	case "DEL":
+		s.del(ctx, sess, w, args)
+	case "EXISTS":
+		s.exists(ctx, sess, w, args)
+	case "MGET":
+		s.mget(ctx, sess, w, args)
+	case "MSET":
+		s.mset(ctx, sess, w, args)
+	case "EXPIRE":
+		s.expire(ctx, sess, w, args)
+	case "TTL":
+		s.ttl(ctx, sess, w, args)
+	case "INCR", "DECR", "INCRBY", "DECRBY":
+		s.incr(ctx, sess, w, name, args)
+	case "SCAN":
+		s.scan(ctx, sess, w, args)
+	case "FLUSHDB":
+		s.flushdb(ctx, sess, w, args)
+	default:
+		w.error(fmt.Sprintf("ERR unknown command '%s'", args[0]))
+	}
+
+	return false
+}
+
+// hello switches the protocol version and optionally authenticates the client.
+func (s *Server) hello(sess *session, w *writer, args [][]byte) {
+	proto := w.proto
+	if len(args) > 1 {
+		v, err := strconv.Atoi(string(args[1]))
+		if err != nil || (v != 2 && v != 3) {
+			w.error("NOPROTO unsupported protocol version")
+			return
+		}
+
+		proto = v
+	}
+
+	for i := 2; i < len(args); i++ {
+		switch strings.ToUpper(string(args[i])) {
+		case "AUTH":
+			if i+2 >= len(args) {
+				w.error("ERR syntax error")
+				return
+			}
+
+			if !s.auth(sess, w, string(args[i+2])) {
+				return
+			}
+
+			i += 2
+		case "SETNAME":
+			if i+1 >= len(args) {
+				w.error("ERR syntax error")
+				return
+			}
+
+			i++
+		default:
+			w.error("ERR syntax error")
+			return
+		}
+	}
+
+	w.proto = proto
+
+	w.mapHeader(7)
+	w.bulkString("server")
+	w.bulkString("nats-cache")
+	w.bulkString("version")
+	w.bulkString("1.0.0")
+	w.bulkString("proto")
+	w.integer(int64(proto))
+	w.bulkString("id")
+	w.integer(0)
+	w.bulkString("mode")
+	w.bulkString("standalone")
+	w.bulkString("role")
+	w.bulkString("master")
+	w.bulkString("modules")
+	w.array(0)
+}
+
+// auth checks the token and writes an error reply if it is not valid.
+func (s *Server) auth(sess *session, w *writer, token string) bool {
+	t, err := s.Authorizer.Authorize("Bearer " + token)
+	if err != nil {
+		s.Logger.Error("failed to authorize redis client", "error", err.Error())
+		w.error("WRONGPASS invalid username-password pair or user is disabled.")
+		return false
+	}
+
+	sess.token = t
+
+	return true
+}
+
+// key returns the internal key of a key sent by the client, keys the bucket does not accept are escaped.
+func (s *Server) key(sess *session, key []byte) string {
+	internalKey, _, _ := keygen.FromToken(*sess.token, sess.db, keygen.Escape(string(key)))
+
+	return internalKey
+}
+
+func (s *Server) get(ctx context.Context, sess *session, w *writer, args [][]byte) {
+	if len(args) != 2 {
+		wrongArgs(w, "GET")
+		return
+	}
+
+	e, err := s.Keyspace.Get(ctx, *sess.token, sess.db, keygen.Escape(string(args[1])))
+	if err != nil {
+		s.storeError(ctx, w, "get", err)
+		return
+	}
+
+	if e == nil {
+		w.null()
+		return
+	}
+
+	if e.Object != "" {
+		w.error("ERR value is stored as an object, use GetObject")
+		return
+	}
+
+	w.bulk(e.Value)
+}
+
+func (s *Server) set(ctx context.Context, sess *session, w *writer, args [][]byte) {
+	if len(args) < 3 {
+		wrongArgs(w, "SET")
+		return
+	}
+
+	var nx, xx bool
+	var ttl int64
+	for i := 3; i < len(args); i++ {
+		switch opt := strings.ToUpper(string(args[i])); opt {
+		case "NX":
+			nx = true
+		case "XX":
+			xx = true
+		case "EX", "PX":
+			if ttl != 0 || i+1 >= len(args) {
+				w.error("ERR syntax error")
+				return
+			}
+
+			n, err := strconv.ParseInt(string(args[i+1]), 10, 64)
+			if err != nil {
+				w.error("ERR value is not an integer or out of range")
+				return
+			}
+
+			if n <= 0 {
+				w.error("ERR invalid expire time in 'set' command")
+				return
+			}
+
+			d := time.Duration(n) * time.Second
+			if opt == "PX" {
+				// the ttl is stored in seconds so milliseconds are rounded up
+				d = (time.Duration(n)*time.Millisecond + time.Second - 1).Truncate(time.Second)
+			}
+
+			ttl = time.Now().Add(d).Unix()
+			i++
+		default:
+			w.error("ERR syntax error")
+			return
+		}
+	}
+
+	if nx && xx {
+		w.error("ERR syntax error")
+		return
+	}
+
+	condition := cachev1.SetCondition_SET_CONDITION_UNSPECIFIED
+	switch {
+	case nx:
+		condition = cachev1.SetCondition_SET_CONDITION_IF_NOT_EXISTS
+	case xx:
+		condition = cachev1.SetCondition_SET_CONDITION_IF_EXISTS
+	}
+
+	_, err := s.Keyspace.Set(ctx, *sess.token, sess.db, keygen.Escape(string(args[1])), storage.Item{Value: args[2], TTL: ttl}, condition, 0)
+
+	if errors.Is(err, storage.ErrKeyExists) || errors.Is(err, storage.ErrKeyNotFound) {
+		w.null()
+		return
+	}
+
+	if err != nil {
+		s.storeError(ctx, w, "set", err)
+		return
+	}
+
+	w.simple("OK")
+}
+
+func (s *Server) del(ctx context.Context, sess *session, w *writer, args [][]byte) {
+	if len(args) < 2 {
+		wrongArgs(w, "DEL")
+		return
+	}
+
+	var n int64
+	for _, k := range args[1:] {
+		e, err := s.Keyspace.Delete(ctx, *sess.token, sess.db, keygen.Escape(string(k)), 0)
+		if err != nil {
+			s.storeError(ctx, w, "delete", err)
+			return
+		}
+
+		if e != nil {
+			n++
+		}
+	}
+
+	w.integer(n)
+}
+
+func (s *Server) exists(ctx context.Context, sess *session, w *writer, args [][]byte) {
+	if len(args) < 2 {
+		wrongArgs(w, "EXISTS")
+		return
+	}
+
+	var n int64
+	for _, k := range args[1:] {
+		e, err := s.Keyspace.Store.Get(ctx, s.key(sess, k))
+		if err != nil {
+			s.storeError(ctx, w, "get", err)
+			return
+		}
+
+		if e != nil {
+			n++
+		}
+	}
+
+	w.integer(n)
+}
+
+func (s *Server) mget(ctx context.Context, sess *session, w *writer, args [][]byte) {
+	if len(args) < 2 {
+		wrongArgs(w, "MGET")
+		return
+	}
+
+	entries := make([]*storage.Entry, len(args)-1)
+	for i, k := range args[1:] {
+		e, err := s.Keyspace.Get(ctx, *sess.token, sess.db, keygen.Escape(string(k)))
+		if err != nil {
+			s.storeError(ctx, w, "get", err)
+			return
+		}
+
+		entries[i] = e
+	}
+
+	w.array(len(entries))
+	for _, e := range entries {
+		// values stored as objects are not returned since they are too large for a reply
+		if e == nil || e.Object != "" {
+			w.null()
+			continue
+		}
+
+		w.bulk(e.Value)
+	}
+}
+
+func (s *Server) mset(ctx context.Context, sess *session, w *writer, args [][]byte) {
+	if len(args) < 3 || len(args)%2 != 1 {
+		wrongArgs(w, "MSET")
+		return
+	}
+
+	for i := 1; i < len(args); i += 2 {
+		if _, err := s.Keyspace.Set(ctx, *sess.token, sess.db, keygen.Escape(string(args[i])), storage.Item{Value: args[i+1]}, cachev1.SetCondition_SET_CONDITION_UNSPECIFIED, 0); err != nil {
+			s.storeError(ctx, w, "set", err)
+			return
+		}
+	}
+
+	w.simple("OK")
+}
+
+func (s *Server) expire(ctx context.Context, sess *session, w *writer, args [][]byte) {
+	if len(args) != 3 {
+		wrongArgs(w, "EXPIRE")
+		return
+	}
+
+	seconds, err := strconv.ParseInt(string(args[2]), 10, 64)
+	if err != nil {
+		w.error("ERR value is not an integer or out of range")
+		return
+	}
+
+	// a ttl in the past deletes the key
+	if seconds <= 0 {
+		e, err := s.Keyspace.Delete(ctx, *sess.token, sess.db, keygen.Escape(string(args[1])), 0)
+		if err != nil {
+			s.storeError(ctx, w, "delete", err)
+			return
+		}
+
+		if e == nil {
+			w.integer(0)
+			return
+		}
+
+		w.integer(1)
+		return
+	}
+
+	_, err = s.Keyspace.Store.Touch(ctx, s.key(sess, args[1]), time.Now().Add(time.Duration(seconds)*time.Second).Unix())
+	if errors.Is(err, storage.ErrKeyNotFound) {
+		w.integer(0)
+		return
+	}
+
+	if err != nil {
+		s.storeError(ctx, w, "touch", err)
+		return
+	}
+
+	w.integer(1)
+}
+
+func (s *Server) ttl(ctx context.Context, sess *session, w *writer, args [][]byte) {
+	if len(args) != 2 {
+		wrongArgs(w, "TTL")
+		return
+	}
+
+	e, err := s.Keyspace.Store.Get(ctx, s.key(sess, args[1]))
+	if err != nil {
+		s.storeError(ctx, w, "get", err)
+		return
+	}
+
+	switch {
+	case e == nil:
+		w.integer(-2)
+	case e.TTL == 0:
+		w.integer(-1)
+	default:
+		w.integer(max(e.TTL-time.Now().Unix(), 0))
+	}
+}
+
+func (s *Server) incr(ctx context.Context, sess *session, w *writer, name string, args [][]byte) {
+	delta := int64(1)
+
+	switch name {
+	case "INCR", "DECR":
+		if len(args) != 2 {
+			wrongArgs(w, name)
+			return
+		}
+	case "INCRBY", "DECRBY":
+		if len(args) != 3 {
+			wrongArgs(w, name)
+			return
+		}
+
+		n, err := strconv.ParseInt(string(args[2]), 10, 64)
+		if err != nil {
+			w.error("ERR value is not an integer or out of range")
+			return
+		}
+
+		delta = n
+	}
+
+	if name == "DECR" || name == "DECRBY" {
+		// the smallest integer has no positive counterpart so it cannot be negated
+		if delta == math.MinInt64 {
+			w.error("ERR decrement would overflow")
+			return
+		}
+
+		delta = -delta
+	}
+
+	v, _, err := s.Keyspace.Store.Increment(ctx, s.key(sess, args[1]), delta, 0)
+	if errors.Is(err, storage.ErrNotInteger) {
+		w.error("ERR value is not an integer or out of range")
+		return
+	}
+
+	if errors.Is(err, storage.ErrOverflow) {
+		w.error("ERR increment or decrement would overflow")
+		return
+	}
+
+	if err != nil {
+		s.storeError(ctx, w, "increment", err)
+		return
+	}
+
+	w.integer(v)
+}
+
+// scan returns the keys of the selected database in pages, the cursor is the position in the sorted keys
+// so keys added or removed between calls may be skipped or returned twice like with Redis.
+func (s *Server) scan(ctx context.Context, sess *session, w *writer, args [][]byte) {
+	if len(args) < 2 {
+		wrongArgs(w, "SCAN")
+		return
+	}
+
+	cursor, err := strconv.Atoi(string(args[1]))
+	if err != nil || cursor < 0 {
+		w.error("ERR invalid cursor")
+		return
+	}
+
+	var match string
+	count := defaultScanCount
+	for i := 2; i < len(args); i += 2 {
+		if i+1 >= len(args) {
+			w.error("ERR syntax error")
+			return
+		}
+
+		switch strings.ToUpper(string(args[i])) {
+		case "MATCH":
+			match = string(args[i+1])
+		case "COUNT":
+			n, err := strconv.Atoi(string(args[i+1]))
+			if err != nil || n < 1 {
+				w.error("ERR syntax error")
+				return
+			}
+
+			count = n
+		case "TYPE":
+			// every key is a string
+			if !strings.EqualFold(string(args[i+1]), "string") {
+				count = 0
+			}
+		default:
+			w.error("ERR syntax error")
+			return
+		}
+	}
+
+	prefix, _, _ := keygen.FromToken(*sess.token, sess.db, "")
+
+	keys, err := s.Keyspace.Store.Keys(ctx, prefix)
+	if err != nil {
+		s.storeError(ctx, w, "keys", err)
+		return
+	}
+
+	var found [][]byte
+	next := cursor
+	for ; next < len(keys) && next < cursor+count; next++ {
+		key := keygen.Unescape(strings.TrimPrefix(keys[next], prefix))
+		if match != "" && !keygen.Match(match, key) {
+			continue
+		}
+
+		// keys may have expired
+		e, err := s.Keyspace.Store.Get(ctx, keys[next])
+		if err != nil {
+			s.storeError(ctx, w, "get", err)
+			return
+		}
+
+		if e != nil {
+			found = append(found, []byte(key))
+		}
+	}
+
+	if next >= len(keys) || count == 0 {
+		next = 0
+	}
+
+	w.array(2)
+	w.bulkString(strconv.Itoa(next))
+	w.array(len(found))
+	for _, k := range found {
+		w.bulk(k)
+	}
+}
+
+func (s *Server) flushdb(ctx context.Context, sess *session, w *writer, args [][]byte) {
+	if len(args) > 2 {
+		wrongArgs(w, "FLUSHDB")
+		return
+	}
+
+	if err := s.Keyspace.Purge(ctx, *sess.token, sess.db, ""); err != nil {
+		s.storeError(ctx, w, "purge", err)
+		return
+	}
+
+	w.simple("OK")
+}
+
+// storeError logs an error from the store and writes a generic error reply.
+func (s *Server) storeError(ctx context.Context, w *writer, op string, err error) {
+	s.Logger.ErrorContext(ctx, "failed to "+op+" key", "error", err.Error())
+
+	if errors.Is(err, storage.ErrValueTooLarge) {
+		w.error("ERR value is too large")
+		return
+	}
+
+	w.error("ERR failed to " + op + " key")
+}
+
+func wrongArgs(w *writer, name string) {
+	w.error(fmt.Sprintf("ERR wrong number of arguments for '%s' command", strings.ToLower(name)))
+}
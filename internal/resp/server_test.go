@@ -0,0 +1,363 @@
+package resp
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jasonmccallister/nats-cache/internal/auth"
+	"github.com/jasonmccallister/nats-cache/internal/cached"
+	"github.com/jasonmccallister/nats-cache/internal/storage"
+	natsserver "github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// subjectAuthorizer uses the token as the subject and rejects the token "invalid".
+type subjectAuthorizer struct{}
+
+func (subjectAuthorizer) Authorize(token string) (*auth.Token, error) {
+	subject := strings.TrimPrefix(token, "Bearer ")
+	if subject == "invalid" {
+		return nil, fmt.Errorf("invalid token")
+	}
+
+	return &auth.Token{Subject: subject}, nil
+}
+
+type client struct {
+	t    *testing.T
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+func newClient(t *testing.T, s storage.Store) *client {
+	return newKeyspaceClient(t, s, storage.NewInMemoryObjects())
+}
+
+func newKeyspaceClient(t *testing.T, s storage.Store, o storage.ObjectStore) *client {
+	l := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	server, conn := net.Pipe()
+	srv := NewServer(l, subjectAuthorizer{}, cached.NewKeyspace(l, s, o))
+	go srv.handle(context.TODO(), server)
+	t.Cleanup(func() { conn.Close() })
+
+	return &client{t: t, conn: conn, r: bufio.NewReader(conn)}
+}
+
+// newNATSStore returns a store using a bucket on an embedded JetStream server, the bucket validates keys
+// the same way as in production.
+func newNATSStore(t *testing.T) storage.Store {
+	t.Helper()
+
+	ns, err := natsserver.NewServer(&natsserver.Options{Port: -1, JetStream: true, StoreDir: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go ns.Start()
+	if !ns.ReadyForConnections(5 * time.Second) {
+		t.Fatal("nats server is not ready")
+	}
+	t.Cleanup(ns.Shutdown)
+
+	nc, err := nats.Connect(ns.ClientURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(nc.Close)
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kv, err := js.CreateKeyValue(context.TODO(), jetstream.KeyValueConfig{Bucket: "cache"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return storage.NewNATSKeyValue(kv, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+// do sends the command and returns the raw reply.
+func (c *client) do(args ...string) string {
+	c.t.Helper()
+
+	cmd := fmt.Sprintf("*%d\r\n", len(args))
+	for _, a := range args {
+		cmd += fmt.Sprintf("$%d\r\n%s\r\n", len(a), a)
+	}
+
+	if _, err := c.conn.Write([]byte(cmd)); err != nil {
+		c.t.Fatal(err)
+	}
+
+	return c.reply()
+}
+
+func (c *client) reply() string {
+	c.t.Helper()
+
+	line, err := c.r.ReadString('\n')
+	if err != nil {
+		c.t.Fatal(err)
+	}
+
+	switch line[0] {
+	case '$':
+		var n int
+		fmt.Sscanf(line, "$%d", &n)
+		if n < 0 {
+			return line
+		}
+
+		b := make([]byte, n+2)
+		if _, err := io.ReadFull(c.r, b); err != nil {
+			c.t.Fatal(err)
+		}
+
+		return line + string(b)
+	case '*', '%':
+		var n int
+		fmt.Sscanf(line[1:], "%d", &n)
+		if line[0] == '%' {
+			n *= 2
+		}
+
+		for i := 0; i < n; i++ {
+			line += c.reply()
+		}
+	}
+
+	return line
+}
+
+func TestServer(t *testing.T) {
+	s := storage.NewInMemory()
+	c := newClient(t, s)
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{args: []string{"PING"}, want: "+PONG\r\n"},
+		{args: []string{"GET", "a"}, want: "-NOAUTH Authentication required.\r\n"},
+		{args: []string{"AUTH", "invalid"}, want: "-WRONGPASS invalid username-password pair or user is disabled.\r\n"},
+		{args: []string{"AUTH", "default", "alice"}, want: "+OK\r\n"},
+		{args: []string{"GET", "a"}, want: "$-1\r\n"},
+		{args: []string{"SET", "a", "1"}, want: "+OK\r\n"},
+		{args: []string{"SET", "a", "2", "NX"}, want: "$-1\r\n"},
+		{args: []string{"SET", "b", "2", "XX"}, want: "$-1\r\n"},
+		{args: []string{"SET", "b", "2", "NX", "EX", "100"}, want: "+OK\r\n"},
+		{args: []string{"TTL", "b"}, want: ":100\r\n"},
+		{args: []string{"TTL", "a"}, want: ":-1\r\n"},
+		{args: []string{"TTL", "missing"}, want: ":-2\r\n"},
+		{args: []string{"EXPIRE", "a", "50"}, want: ":1\r\n"},
+		{args: []string{"EXPIRE", "missing", "50"}, want: ":0\r\n"},
+		{args: []string{"INCR", "a"}, want: ":2\r\n"},
+		{args: []string{"INCRBY", "a", "10"}, want: ":12\r\n"},
+		{args: []string{"DECRBY", "a", "-9223372036854775808"}, want: "-ERR decrement would overflow\r\n"},
+		{args: []string{"MSET", "c", "3", "d", "4"}, want: "+OK\r\n"},
+		{args: []string{"MGET", "a", "missing", "c"}, want: "*3\r\n$2\r\n12\r\n$-1\r\n$1\r\n3\r\n"},
+		{args: []string{"EXISTS", "a", "b", "missing"}, want: ":2\r\n"},
+		{args: []string{"SCAN", "0", "MATCH", "c*", "COUNT", "100"}, want: "*2\r\n$1\r\n0\r\n*1\r\n$1\r\nc\r\n"},
+		{args: []string{"SCAN", "0", "COUNT", "2"}, want: "*2\r\n$1\r\n2\r\n*2\r\n$1\r\na\r\n$1\r\nb\r\n"},
+		{args: []string{"SCAN", "2", "COUNT", "2"}, want: "*2\r\n$1\r\n0\r\n*2\r\n$1\r\nc\r\n$1\r\nd\r\n"},
+		{args: []string{"DEL", "c", "missing"}, want: ":1\r\n"},
+		{args: []string{"SELECT", "1"}, want: "+OK\r\n"},
+		{args: []string{"GET", "a"}, want: "$-1\r\n"},
+		{args: []string{"SET", "a", "db1"}, want: "+OK\r\n"},
+		{args: []string{"SET", "b", "db1"}, want: "+OK\r\n"},
+		{args: []string{"FLUSHDB"}, want: "+OK\r\n"},
+		{args: []string{"GET", "a"}, want: "$-1\r\n"},
+		{args: []string{"SCAN", "0"}, want: "*2\r\n$1\r\n0\r\n*0\r\n"},
+		{args: []string{"SELECT", "0"}, want: "+OK\r\n"},
+		{args: []string{"GET", "a"}, want: "$2\r\n12\r\n"},
+		{args: []string{"HELLO", "3"}, want: "%7\r\n$6\r\nserver\r\n$10\r\nnats-cache\r\n$7\r\nversion\r\n$5\r\n1.0.0\r\n$5\r\nproto\r\n:3\r\n$2\r\nid\r\n:0\r\n$4\r\nmode\r\n$10\r\nstandalone\r\n$4\r\nrole\r\n$6\r\nmaster\r\n$7\r\nmodules\r\n*0\r\n"},
+		{args: []string{"GET", "missing"}, want: "_\r\n"},
+		{args: []string{"NOPE"}, want: "-ERR unknown command 'NOPE'\r\n"},
+		{args: []string{"GET"}, want: "-ERR wrong number of arguments for 'get' command\r\n"},
+	}
+	for _, tt := range tests {
+		if got := c.do(tt.args...); got != tt.want {
+			t.Errorf("%v = %q, want %q", tt.args, got, tt.want)
+		}
+	}
+
+	// the keys are scoped to the subject like the cache service
+	e, err := s.Get(context.TODO(), "alice.0-a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e == nil || string(e.Value) != "12" {
+		t.Errorf("Get() = %v, want 12", e)
+	}
+
+	// FLUSHDB removed the keys of database 1 only
+	keys, err := s.Keys(context.TODO(), "alice.1-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 0 {
+		t.Errorf("Keys() after FLUSHDB = %v, want no keys", keys)
+	}
+
+	other := newClient(t, s)
+	other.do("AUTH", "bob")
+	if got := other.do("GET", "a"); got != "$-1\r\n" {
+		t.Errorf("GET a for another subject = %q, want a null", got)
+	}
+}
+
+func TestServer_inline(t *testing.T) {
+	c := newClient(t, storage.NewInMemory())
+
+	if _, err := c.conn.Write([]byte("PING hello\r\n")); err != nil {
+		t.Fatal(err)
+	}
+	if got := c.reply(); got != "$5\r\nhello\r\n" {
+		t.Errorf("PING hello = %q, want hello", got)
+	}
+}
+
+func TestServer_keyspace(t *testing.T) {
+	s := storage.NewInMemory()
+	o := storage.NewInMemoryObjects()
+	c := newKeyspaceClient(t, s, o)
+	c.do("AUTH", "alice")
+
+	// written by the cache service with a tag and an object
+	if _, err := s.Set(context.TODO(), "alice.0-a", storage.Item{Object: "alice.0-a/1", Tags: []string{"users"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := storage.TagAdd(context.TODO(), s, "alice.0.tag-dXNlcnM", "alice.0-a"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := o.Put(context.TODO(), "alice.0-a/1", strings.NewReader("large")); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := c.do("SET", "a", "1"); got != "+OK\r\n" {
+		t.Fatalf("SET a = %q, want OK", got)
+	}
+
+	index, _, err := storage.SetGet(context.TODO(), s, "alice.0.tag-dXNlcnM")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(index) != 0 {
+		t.Errorf("tag index = %v, want the key removed", index.Members())
+	}
+
+	if _, err := o.Get(context.TODO(), "alice.0-a/1"); err == nil {
+		t.Error("object of the previous value was not removed")
+	}
+
+	// reads push a sliding expiration forward
+	if _, err := s.Set(context.TODO(), "alice.0-b", storage.Item{Value: []byte("2"), TTL: time.Now().Unix() + 10, Sliding: 100}); err != nil {
+		t.Fatal(err)
+	}
+
+	c.do("GET", "b")
+
+	// the refresh is written in the background
+	deadline := time.Now().Add(time.Second)
+	for {
+		got := c.do("TTL", "b")
+		if got != ":10\r\n" && got != ":9\r\n" {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("TTL b after GET = %q, want the sliding window", got)
+		}
+
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestServer_escapedKeys(t *testing.T) {
+	s := newNATSStore(t)
+	c := newClient(t, s)
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{args: []string{"AUTH", "alice"}, want: "+OK\r\n"},
+		{args: []string{"SET", "user:42", "a"}, want: "+OK\r\n"},
+		{args: []string{"SET", "user.43", "b"}, want: "+OK\r\n"},
+		{args: []string{"GET", "user:42"}, want: "$1\r\na\r\n"},
+		{args: []string{"MSET", "a b", "c", "a.", "d"}, want: "+OK\r\n"},
+		{args: []string{"MGET", "a b", "a."}, want: "*2\r\n$1\r\nc\r\n$1\r\nd\r\n"},
+		{args: []string{"INCR", "count:*"}, want: ":1\r\n"},
+		{args: []string{"EXPIRE", "user:42", "100"}, want: ":1\r\n"},
+		{args: []string{"TTL", "user:42"}, want: ":100\r\n"},
+		{args: []string{"EXISTS", "user:42", "a b"}, want: ":2\r\n"},
+		{args: []string{"SCAN", "0", "MATCH", "user*", "COUNT", "100"}, want: "*2\r\n$1\r\n0\r\n*2\r\n$7\r\nuser:42\r\n$7\r\nuser.43\r\n"},
+		{args: []string{"DEL", "user:42", "a b"}, want: ":2\r\n"},
+		{args: []string{"GET", "user:42"}, want: "$-1\r\n"},
+	}
+	for _, tt := range tests {
+		if got := c.do(tt.args...); got != tt.want {
+			t.Errorf("%v = %q, want %q", tt.args, got, tt.want)
+		}
+	}
+
+	// keys the bucket accepts are shared with the cache service
+	e, err := s.Get(context.TODO(), "alice.0-user.43")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e == nil || string(e.Value) != "b" {
+		t.Errorf("Get() = %v, want b", e)
+	}
+}
+
+func TestServer_unauthenticatedLimits(t *testing.T) {
+	tests := []struct {
+		name string
+		cmd  string
+		want string
+	}{
+		{
+			name: "should refuse many arguments",
+			cmd:  "*1000000\r\n",
+			want: "-ERR protocol error: invalid multibulk length\r\n",
+		},
+		{
+			name: "should refuse a large bulk string",
+			cmd:  "*3\r\n$3\r\nSET\r\n$1\r\na\r\n$67108864\r\n",
+			want: "-ERR protocol error: invalid bulk length\r\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClient(t, storage.NewInMemory())
+
+			if _, err := c.conn.Write([]byte(tt.cmd)); err != nil {
+				t.Fatal(err)
+			}
+			if got := c.reply(); got != tt.want {
+				t.Errorf("%q = %q, want %q", tt.cmd, got, tt.want)
+			}
+		})
+	}
+
+	// the limits are lifted once the client is authenticated
+	c := newClient(t, storage.NewInMemory())
+	c.do("AUTH", "alice")
+
+	value := strings.Repeat("a", 64*1024)
+	if got := c.do("SET", "a", value); got != "+OK\r\n" {
+		t.Errorf("SET a with a large value = %q, want OK", got)
+	}
+}
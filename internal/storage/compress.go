@@ -0,0 +1,404 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Codec is the compression applied to the value of an item, items with no codec are stored as is.
+type Codec string
+
+const (
+	CodecNone Codec = ""
+	CodecS2   Codec = "s2"
+	CodecZstd Codec = "zstd"
+)
+
+// DefaultCompressionThreshold is the size in bytes a value must reach before it is compressed.
+const DefaultCompressionThreshold = 1024
+
+type compressed struct {
+	Store
+
+	codec     Codec
+	threshold int
+	logger    *slog.Logger
+
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+// NewCompressed returns a store that compresses values of at least threshold bytes with the codec before
+// they are written to s. The codec is stored with the item so values are decompressed on read no matter
+// which codec they were written with, including values written without compression.
+func NewCompressed(s Store, logger *slog.Logger, codec Codec, threshold int) (Store, error) {
+	c := &compressed{
+		Store:     s,
+		codec:     codec,
+		threshold: threshold,
+		logger:    logger,
+	}
+
+	switch codec {
+	case CodecNone, CodecS2, CodecZstd:
+	default:
+		return nil, fmt.Errorf("unknown compression codec %q", codec)
+	}
+
+	var err error
+	c.encoder, err = zstd.NewWriter(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.decoder, err = zstd.NewReader(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// compress returns the item with the value compressed if it reaches the threshold. If the compressed
+// value is not smaller the item is returned as is.
+func (c *compressed) compress(ctx context.Context, key string, i Item) Item {
+	if c.codec == CodecNone || len(i.Value) < c.threshold {
+		return i
+	}
+
+	start := time.Now()
+
+	var b []byte
+	switch c.codec {
+	case CodecS2:
+		b = s2.Encode(nil, i.Value)
+	case CodecZstd:
+		b = c.encoder.EncodeAll(i.Value, nil)
+	}
+
+	c.logger.DebugContext(ctx, "compressed value",
+		"key", key,
+		"codec", string(c.codec),
+		"size", len(i.Value),
+		"compressed", len(b),
+		"ratio", float64(len(i.Value))/float64(len(b)),
+		"duration", time.Since(start).String(),
+	)
+
+	if len(b) >= len(i.Value) {
+		return i
+	}
+
+	i.Value = b
+	i.Codec = c.codec
+
+	return i
+}
+
+// decompress replaces the value of the item with the decompressed value and clears the codec.
+func (c *compressed) decompress(ctx context.Context, key string, i *Item) error {
+	if i.Codec == CodecNone {
+		return nil
+	}
+
+	start := time.Now()
+
+	var b []byte
+	var err error
+	switch i.Codec {
+	case CodecS2:
+		b, err = s2.Decode(nil, i.Value)
+	case CodecZstd:
+		b, err = c.decoder.DecodeAll(i.Value, nil)
+	default:
+		err = fmt.Errorf("unknown compression codec %q", i.Codec)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decompress %s: %w", key, err)
+	}
+
+	c.logger.DebugContext(ctx, "decompressed value",
+		"key", key,
+		"codec", string(i.Codec),
+		"size", len(b),
+		"compressed", len(i.Value),
+		"duration", time.Since(start).String(),
+	)
+
+	i.Value = b
+	i.Codec = CodecNone
+
+	return nil
+}
+
+func (c *compressed) entry(ctx context.Context, key string, e *Entry, err error) (*Entry, error) {
+	if err != nil || e == nil {
+		return e, err
+	}
+
+	if err := c.decompress(ctx, key, &e.Item); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func (c *compressed) Create(ctx context.Context, key string, i Item) (uint64, error) {
+	return c.Store.Create(ctx, key, c.compress(ctx, key, i))
+}
+
+func (c *compressed) Get(ctx context.Context, key string) (*Entry, error) {
+	e, err := c.Store.Get(ctx, key)
+
+	return c.entry(ctx, key, e, err)
+}
+
+func (c *compressed) GetRevision(ctx context.Context, key string, revision uint64) (*Entry, error) {
+	e, err := c.Store.GetRevision(ctx, key, revision)
+
+	return c.entry(ctx, key, e, err)
+}
+
+func (c *compressed) GetAndDelete(ctx context.Context, key string) (*Entry, error) {
+	e, err := c.Store.GetAndDelete(ctx, key)
+
+	return c.entry(ctx, key, e, err)
+}
+
+func (c *compressed) GetAndSet(ctx context.Context, key string, i Item) (*Entry, uint64, error) {
+	e, revision, err := c.Store.GetAndSet(ctx, key, c.compress(ctx, key, i))
+
+	e, err = c.entry(ctx, key, e, err)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return e, revision, nil
+}
+
+func (c *compressed) History(ctx context.Context, key string) ([]Event, error) {
+	events, err := c.Store.History(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range events {
+		if err := c.decompress(ctx, events[i].Key, &events[i].Item); err != nil {
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
+
+func (c *compressed) Set(ctx context.Context, key string, i Item) (uint64, error) {
+	return c.Store.Set(ctx, key, c.compress(ctx, key, i))
+}
+
+func (c *compressed) Update(ctx context.Context, key string, i Item, revision uint64) (uint64, error) {
+	return c.Store.Update(ctx, key, c.compress(ctx, key, i), revision)
+}
+
+func (c *compressed) Watch(ctx context.Context, key string, prefix bool) (<-chan Event, error) {
+	events, err := c.Store.Watch(ctx, key, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan Event)
+	go func() {
+		defer close(out)
+
+		for e := range events {
+			// an event that cannot be decompressed is sent without the value rather than stopping the watch
+			if err := c.decompress(ctx, e.Key, &e.Item); err != nil {
+				c.logger.ErrorContext(ctx, "failed to decompress event", "key", e.Key, "error", err.Error())
+				e.Value = nil
+			}
+
+			select {
+			case out <- e:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// compressedObjectMagic marks an object compressed by the store returned by NewCompressedObjects, it is
+// followed by the length of the codec and the codec. Objects without it are read as is.
+var compressedObjectMagic = []byte("NCZ1")
+
+type compressedObjects struct {
+	ObjectStore
+
+	codec  Codec
+	logger *slog.Logger
+}
+
+// NewCompressedObjects returns an object store that compresses objects written to o with the codec. Objects
+// are compressed as they are streamed, there is no threshold since objects are large values. The codec is
+// written at the start of the object so objects are decompressed on read no matter which codec they were
+// written with, including objects written without compression.
+func NewCompressedObjects(o ObjectStore, logger *slog.Logger, codec Codec) (ObjectStore, error) {
+	switch codec {
+	case CodecNone, CodecS2, CodecZstd:
+	default:
+		return nil, fmt.Errorf("unknown compression codec %q", codec)
+	}
+
+	return &compressedObjects{
+		ObjectStore: o,
+		codec:       codec,
+		logger:      logger,
+	}, nil
+}
+
+func (c *compressedObjects) Put(ctx context.Context, name string, r io.Reader) (uint64, error) {
+	if c.codec == CodecNone {
+		return c.ObjectStore.Put(ctx, name, r)
+	}
+
+	start := time.Now()
+
+	// the object is compressed as the underlying store reads it, the goroutine is always waited for so it
+	// does not read from r after Put returns
+	pr, pw := io.Pipe()
+	cr := &countingReader{r: r}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		pw.CloseWithError(c.compress(pw, cr))
+	}()
+
+	size, err := c.ObjectStore.Put(ctx, name, pr)
+	pr.CloseWithError(err)
+	<-done
+	if err != nil {
+		return 0, err
+	}
+
+	c.logger.DebugContext(ctx, "compressed object",
+		"name", name,
+		"codec", string(c.codec),
+		"size", cr.n,
+		"compressed", size,
+		"duration", time.Since(start).String(),
+	)
+
+	return cr.n, nil
+}
+
+// compress writes the header and the compressed object read from r to w.
+func (c *compressedObjects) compress(w io.Writer, r io.Reader) error {
+	header := append(bytes.Clone(compressedObjectMagic), byte(len(c.codec)))
+	if _, err := w.Write(append(header, c.codec...)); err != nil {
+		return err
+	}
+
+	var enc io.WriteCloser
+	switch c.codec {
+	case CodecS2:
+		enc = s2.NewWriter(w)
+	case CodecZstd:
+		z, err := zstd.NewWriter(w)
+		if err != nil {
+			return err
+		}
+
+		enc = z
+	}
+
+	if _, err := io.Copy(enc, r); err != nil {
+		enc.Close()
+		return err
+	}
+
+	return enc.Close()
+}
+
+func (c *compressedObjects) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	rc, err := c.ObjectStore.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReader(rc)
+
+	header, err := br.Peek(len(compressedObjectMagic) + 1)
+	if err != nil && !errors.Is(err, io.EOF) {
+		rc.Close()
+		return nil, err
+	}
+
+	if len(header) < len(compressedObjectMagic)+1 || !bytes.HasPrefix(header, compressedObjectMagic) {
+		return readCloser{Reader: br, Closer: rc}, nil
+	}
+
+	n := len(header) + int(header[len(compressedObjectMagic)])
+	header, err = br.Peek(n)
+	if err != nil {
+		rc.Close()
+		return nil, fmt.Errorf("failed to decompress %s: %w", name, err)
+	}
+
+	codec := Codec(header[len(compressedObjectMagic)+1:])
+	if _, err := br.Discard(n); err != nil {
+		rc.Close()
+		return nil, err
+	}
+
+	switch codec {
+	case CodecS2:
+		return readCloser{Reader: s2.NewReader(br), Closer: rc}, nil
+	case CodecZstd:
+		d, err := zstd.NewReader(br)
+		if err != nil {
+			rc.Close()
+			return nil, err
+		}
+
+		return zstdReadCloser{Decoder: d, rc: rc}, nil
+	default:
+		rc.Close()
+		return nil, fmt.Errorf("failed to decompress %s: unknown compression codec %q", name, codec)
+	}
+}
+
+// zstdReadCloser releases the decoder along with the object.
+type zstdReadCloser struct {
+	*zstd.Decoder
+
+	rc io.Closer
+}
+
+func (z zstdReadCloser) Close() error {
+	z.Decoder.Close()
+
+	return z.rc.Close()
+}
+
+// countingReader counts the bytes read from r.
+type countingReader struct {
+	r io.Reader
+	n uint64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += uint64(n)
+
+	return n, err
+}
@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+)
+
+func TestCompressed(t *testing.T) {
+	large := bytes.Repeat([]byte("<div>fragment</div>"), 100)
+
+	for _, codec := range []Codec{CodecS2, CodecZstd} {
+		t.Run(string(codec), func(t *testing.T) {
+			inner := NewInMemory()
+			s, err := NewCompressed(inner, slog.New(slog.NewTextHandler(io.Discard, nil)), codec, 64)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := s.Set(context.TODO(), "large", Item{Value: large}); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := s.Set(context.TODO(), "small", Item{Value: []byte("small")}); err != nil {
+				t.Fatal(err)
+			}
+			// written before compression was enabled
+			if _, err := inner.Set(context.TODO(), "legacy", Item{Value: large}); err != nil {
+				t.Fatal(err)
+			}
+
+			raw, err := inner.Get(context.TODO(), "large")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if raw.Codec != codec || len(raw.Value) >= len(large) {
+				t.Errorf("stored codec = %q and size = %d, want %q and less than %d", raw.Codec, len(raw.Value), codec, len(large))
+			}
+
+			raw, err = inner.Get(context.TODO(), "small")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if raw.Codec != CodecNone {
+				t.Errorf("stored codec = %q, want none below the threshold", raw.Codec)
+			}
+
+			for _, key := range []string{"large", "legacy"} {
+				e, err := s.Get(context.TODO(), key)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(e.Value, large) || e.Codec != CodecNone {
+					t.Errorf("Get(%s) did not return the decompressed value", key)
+				}
+			}
+
+			prev, _, err := s.GetAndSet(context.TODO(), "large", Item{Value: []byte("replaced")})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(prev.Value, large) {
+				t.Errorf("GetAndSet() did not return the decompressed previous value")
+			}
+		})
+	}
+}
+
+func TestNewCompressed_unknownCodec(t *testing.T) {
+	if _, err := NewCompressed(NewInMemory(), slog.New(slog.NewTextHandler(io.Discard, nil)), "lz4", 0); err == nil {
+		t.Error("NewCompressed() error = nil, want an error for an unknown codec")
+	}
+}
+
+func TestCompressedObjects(t *testing.T) {
+	large := bytes.Repeat([]byte("<div>fragment</div>"), 10000)
+
+	for _, codec := range []Codec{CodecS2, CodecZstd} {
+		t.Run(string(codec), func(t *testing.T) {
+			inner := NewInMemoryObjects()
+			o, err := NewCompressedObjects(inner, slog.New(slog.NewTextHandler(io.Discard, nil)), codec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			size, err := o.Put(context.TODO(), "alice.0-page/1", bytes.NewReader(large))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if size != uint64(len(large)) {
+				t.Errorf("Put() = %d, want %d", size, len(large))
+			}
+
+			if raw := readObject(t, inner, "alice.0-page/1"); len(raw) >= len(large) {
+				t.Errorf("stored object is %d bytes, want less than %d", len(raw), len(large))
+			}
+
+			if got := readObject(t, o, "alice.0-page/1"); !bytes.Equal(got, large) {
+				t.Errorf("Get() returned %d bytes, want %d", len(got), len(large))
+			}
+
+			// written before compression was enabled
+			if _, err := inner.Put(context.TODO(), "alice.0-legacy/1", bytes.NewReader([]byte("legacy"))); err != nil {
+				t.Fatal(err)
+			}
+			if got := readObject(t, o, "alice.0-legacy/1"); string(got) != "legacy" {
+				t.Errorf("Get() = %s, want legacy", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,554 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"sync"
+
+	"github.com/jasonmccallister/nats-cache/internal/keygen"
+	"golang.org/x/crypto/hkdf"
+)
+
+// MinMasterKeySize is the minimum size in bytes of a master key used to derive data keys.
+const MinMasterKeySize = 32
+
+// Encrypted is a store that encrypts the value of every item with AES-GCM before it is written to the
+// underlying store. Each subject has its own data key derived from the master key so a value can only be
+// decrypted with the key of the subject it was written for.
+type Encrypted struct {
+	Store
+
+	// masters are the master keys ordered by version, the newest key is used for writes
+	masters [][]byte
+	logger  *slog.Logger
+
+	// aeads caches the cipher for each key version and subject
+	aeads sync.Map
+}
+
+// NewEncrypted returns a store that encrypts values written to s using the newest of the master keys,
+// ordered from oldest to newest. The version of the master key is stored with the item so values
+// written with an older key, or without encryption, can still be read after a new key is added.
+func NewEncrypted(s Store, logger *slog.Logger, masters [][]byte) (*Encrypted, error) {
+	if len(masters) == 0 {
+		return nil, fmt.Errorf("at least one master key is required")
+	}
+
+	for i, m := range masters {
+		if len(m) < MinMasterKeySize {
+			return nil, fmt.Errorf("master key %d must be at least %d bytes", i+1, MinMasterKeySize)
+		}
+	}
+
+	return &Encrypted{
+		Store:   s,
+		masters: masters,
+		logger:  logger,
+	}, nil
+}
+
+// aead returns the cipher for the subject of the key using the master key version.
+func (c *Encrypted) aead(key string, version uint32) (cipher.AEAD, error) {
+	if version == 0 || int(version) > len(c.masters) {
+		return nil, fmt.Errorf("unknown encryption key version %d", version)
+	}
+
+	// keys created outside of keygen share the data key of the empty subject
+	subject, _ := keygen.Subject(key)
+
+	id := strconv.FormatUint(uint64(version), 10) + ":" + subject
+	if a, ok := c.aeads.Load(id); ok {
+		return a.(cipher.AEAD), nil
+	}
+
+	dk := make([]byte, 32)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, c.masters[version-1], nil, []byte(subject)), dk); err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(dk)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	c.aeads.Store(id, a)
+
+	return a, nil
+}
+
+// encrypt returns the item with the value encrypted with the newest master key. The key is used as
+// additional data so an encrypted value cannot be moved to another key.
+func (c *Encrypted) encrypt(key string, i Item) (Item, error) {
+	version := uint32(len(c.masters))
+
+	a, err := c.aead(key, version)
+	if err != nil {
+		return Item{}, err
+	}
+
+	nonce := make([]byte, a.NonceSize(), a.NonceSize()+len(i.Value)+a.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return Item{}, err
+	}
+
+	i.Value = a.Seal(nonce, nonce, i.Value, []byte(key))
+	i.KeyVersion = version
+
+	return i, nil
+}
+
+// decrypt replaces the value of the item with the decrypted value and clears the key version.
+func (c *Encrypted) decrypt(key string, i *Item) error {
+	if i.KeyVersion == 0 {
+		return nil
+	}
+
+	a, err := c.aead(key, i.KeyVersion)
+	if err != nil {
+		return err
+	}
+
+	if len(i.Value) < a.NonceSize() {
+		return fmt.Errorf("failed to decrypt %s: value is too short", key)
+	}
+
+	b, err := a.Open(nil, i.Value[:a.NonceSize()], i.Value[a.NonceSize():], []byte(key))
+	if err != nil {
+		return fmt.Errorf("failed to decrypt %s: %w", key, err)
+	}
+
+	i.Value = b
+	i.KeyVersion = 0
+
+	return nil
+}
+
+func (c *Encrypted) entry(key string, e *Entry, err error) (*Entry, error) {
+	if err != nil || e == nil {
+		return e, err
+	}
+
+	if err := c.decrypt(key, &e.Item); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func (c *Encrypted) Create(ctx context.Context, key string, i Item) (uint64, error) {
+	i, err := c.encrypt(key, i)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.Store.Create(ctx, key, i)
+}
+
+func (c *Encrypted) Get(ctx context.Context, key string) (*Entry, error) {
+	e, err := c.Store.Get(ctx, key)
+
+	return c.entry(key, e, err)
+}
+
+func (c *Encrypted) GetRevision(ctx context.Context, key string, revision uint64) (*Entry, error) {
+	e, err := c.Store.GetRevision(ctx, key, revision)
+
+	return c.entry(key, e, err)
+}
+
+func (c *Encrypted) GetAndDelete(ctx context.Context, key string) (*Entry, error) {
+	e, err := c.Store.GetAndDelete(ctx, key)
+
+	return c.entry(key, e, err)
+}
+
+func (c *Encrypted) GetAndSet(ctx context.Context, key string, i Item) (*Entry, uint64, error) {
+	i, err := c.encrypt(key, i)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	e, revision, err := c.Store.GetAndSet(ctx, key, i)
+
+	e, err = c.entry(key, e, err)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return e, revision, nil
+}
+
+func (c *Encrypted) History(ctx context.Context, key string) ([]Event, error) {
+	events, err := c.Store.History(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range events {
+		if err := c.decrypt(events[i].Key, &events[i].Item); err != nil {
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
+
+// Increment is applied to the decrypted value since the underlying store cannot read the integer.
+func (c *Encrypted) Increment(ctx context.Context, key string, delta int64, ttl int64) (int64, int64, error) {
+	var value int64
+	_, err := Mutate(ctx, c, key, func(e *Entry) (Item, error) {
+		if e == nil {
+			v, err := incr(nil, delta)
+			if err != nil {
+				return Item{}, err
+			}
+
+			value = v
+
+			return Item{Value: []byte(strconv.FormatInt(v, 10)), TTL: ttl}, nil
+		}
+
+		v, err := incr(e.Value, delta)
+		if err != nil {
+			return Item{}, err
+		}
+
+		value, ttl = v, e.TTL
+
+		i := e.Item
+		i.Value = []byte(strconv.FormatInt(v, 10))
+
+		return i, nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return value, ttl, nil
+}
+
+func (c *Encrypted) Set(ctx context.Context, key string, i Item) (uint64, error) {
+	i, err := c.encrypt(key, i)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.Store.Set(ctx, key, i)
+}
+
+func (c *Encrypted) Update(ctx context.Context, key string, i Item, revision uint64) (uint64, error) {
+	i, err := c.encrypt(key, i)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.Store.Update(ctx, key, i, revision)
+}
+
+func (c *Encrypted) Watch(ctx context.Context, key string, prefix bool) (<-chan Event, error) {
+	events, err := c.Store.Watch(ctx, key, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan Event)
+	go func() {
+		defer close(out)
+
+		for e := range events {
+			// an event that cannot be decrypted is sent without the value rather than stopping the watch
+			if err := c.decrypt(e.Key, &e.Item); err != nil {
+				c.logger.ErrorContext(ctx, "failed to decrypt event", "key", e.Key, "error", err.Error())
+				e.Value = nil
+			}
+
+			select {
+			case out <- e:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// Reencrypt rewrites every value that was not written with the newest master key and returns the number
+// of values that were rewritten. A value written by another client while it is being rewritten is skipped
+// since the new write already uses the newest key.
+func (c *Encrypted) Reencrypt(ctx context.Context) (int, error) {
+	keys, err := c.Store.Keys(ctx, "")
+	if err != nil {
+		return 0, err
+	}
+
+	version := uint32(len(c.masters))
+
+	var n int
+	for _, key := range keys {
+		if err := ctx.Err(); err != nil {
+			return n, err
+		}
+
+		e, err := c.Store.Get(ctx, key)
+		if err != nil {
+			return n, err
+		}
+
+		if e == nil || e.KeyVersion == version {
+			continue
+		}
+
+		if err := c.decrypt(key, &e.Item); err != nil {
+			return n, err
+		}
+
+		_, err = c.Update(ctx, key, e.Item, e.Revision)
+		if errors.Is(err, ErrRevisionMismatch) {
+			continue
+		}
+
+		if err != nil {
+			return n, err
+		}
+
+		n++
+	}
+
+	return n, nil
+}
+
+// objectMagic marks an object encrypted by the store returned by Objects, objects without it were written
+// before encryption was enabled and are read as is.
+var objectMagic = []byte("NCE1")
+
+// objectSegmentSize is the size of the plaintext sealed in each segment of an encrypted object.
+const objectSegmentSize = 64 * 1024
+
+// Objects returns an object store that encrypts objects written to o with the data key of the subject of
+// the object name. Objects are streamed in segments so they are never held in memory, each segment is
+// sealed with the object name, its position and whether it is the last segment as additional data so
+// segments cannot be moved, reordered or truncated. The size returned by List is the encrypted size.
+func (c *Encrypted) Objects(o ObjectStore) ObjectStore {
+	return &encryptedObjects{ObjectStore: o, c: c}
+}
+
+type encryptedObjects struct {
+	ObjectStore
+
+	c *Encrypted
+}
+
+func (o *encryptedObjects) Put(ctx context.Context, name string, r io.Reader) (uint64, error) {
+	version := uint32(len(o.c.masters))
+
+	a, err := o.c.aead(name, version)
+	if err != nil {
+		return 0, err
+	}
+
+	header := make([]byte, len(objectMagic)+4)
+	copy(header, objectMagic)
+	binary.BigEndian.PutUint32(header[len(objectMagic):], version)
+
+	s := &sealReader{r: r, a: a, name: name, buf: header, segment: make([]byte, objectSegmentSize)}
+	if _, err := o.ObjectStore.Put(ctx, name, s); err != nil {
+		return 0, err
+	}
+
+	return s.size, nil
+}
+
+func (o *encryptedObjects) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	rc, err := o.ObjectStore.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReader(rc)
+
+	header, err := br.Peek(len(objectMagic) + 4)
+	if err != nil && !errors.Is(err, io.EOF) {
+		rc.Close()
+		return nil, err
+	}
+
+	if len(header) < len(objectMagic)+4 || !bytes.HasPrefix(header, objectMagic) {
+		return readCloser{Reader: br, Closer: rc}, nil
+	}
+
+	a, err := o.c.aead(name, binary.BigEndian.Uint32(header[len(objectMagic):]))
+	if err != nil {
+		rc.Close()
+		return nil, err
+	}
+
+	if _, err := br.Discard(len(header)); err != nil {
+		rc.Close()
+		return nil, err
+	}
+
+	return readCloser{Reader: &openReader{r: br, a: a, name: name}, Closer: rc}, nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// segmentData returns the additional data of a segment of the object.
+func segmentData(name string, index uint64, last bool) []byte {
+	b := make([]byte, 0, len(name)+9)
+	b = append(b, name...)
+	b = binary.BigEndian.AppendUint64(b, index)
+	if last {
+		return append(b, 1)
+	}
+
+	return append(b, 0)
+}
+
+// sealReader reads the plaintext from r and returns the encrypted object. Each segment is written as a
+// flag marking the last segment, the length of the sealed segment and the nonce followed by the sealed
+// segment.
+type sealReader struct {
+	r    io.Reader
+	a    cipher.AEAD
+	name string
+
+	buf     []byte
+	segment []byte
+	index   uint64
+	size    uint64
+	done    bool
+}
+
+func (s *sealReader) Read(p []byte) (int, error) {
+	for len(s.buf) == 0 {
+		if s.done {
+			return 0, io.EOF
+		}
+
+		if err := s.seal(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, s.buf)
+	s.buf = s.buf[n:]
+
+	return n, nil
+}
+
+func (s *sealReader) seal() error {
+	n, err := io.ReadFull(s.r, s.segment)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return err
+	}
+
+	// a full segment is not known to be the last until the next read returns nothing
+	last := err != nil
+	s.size += uint64(n)
+
+	record := make([]byte, 5+s.a.NonceSize(), 5+s.a.NonceSize()+n+s.a.Overhead())
+	if last {
+		record[0] = 1
+	}
+
+	nonce := record[5:]
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	record = s.a.Seal(record, nonce, s.segment[:n], segmentData(s.name, s.index, last))
+	binary.BigEndian.PutUint32(record[1:5], uint32(len(record)-5))
+
+	s.buf = record
+	s.index++
+	s.done = last
+
+	return nil
+}
+
+// openReader reads an encrypted object from r and returns the plaintext.
+type openReader struct {
+	r    *bufio.Reader
+	a    cipher.AEAD
+	name string
+
+	buf   []byte
+	index uint64
+	done  bool
+}
+
+func (o *openReader) Read(p []byte) (int, error) {
+	for len(o.buf) == 0 {
+		if o.done {
+			return 0, io.EOF
+		}
+
+		if err := o.open(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, o.buf)
+	o.buf = o.buf[n:]
+
+	return n, nil
+}
+
+func (o *openReader) open() error {
+	header := make([]byte, 5)
+	if _, err := io.ReadFull(o.r, header); err != nil {
+		// the object ended before the last segment
+		if errors.Is(err, io.EOF) {
+			return io.ErrUnexpectedEOF
+		}
+
+		return err
+	}
+
+	n := binary.BigEndian.Uint32(header[1:])
+	if n < uint32(o.a.NonceSize()+o.a.Overhead()) || n > uint32(o.a.NonceSize()+objectSegmentSize+o.a.Overhead()) {
+		return fmt.Errorf("failed to decrypt %s: invalid segment length", o.name)
+	}
+
+	record := make([]byte, n)
+	if _, err := io.ReadFull(o.r, record); err != nil {
+		if errors.Is(err, io.EOF) {
+			return io.ErrUnexpectedEOF
+		}
+
+		return err
+	}
+
+	last := header[0] == 1
+
+	b, err := o.a.Open(record[o.a.NonceSize():o.a.NonceSize()], record[:o.a.NonceSize()], record[o.a.NonceSize():], segmentData(o.name, o.index, last))
+	if err != nil {
+		return fmt.Errorf("failed to decrypt %s: %w", o.name, err)
+	}
+
+	o.buf = b
+	o.index++
+	o.done = last
+
+	return nil
+}
@@ -0,0 +1,220 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+)
+
+func testEncrypted(t *testing.T, s Store, masters ...[]byte) *Encrypted {
+	t.Helper()
+
+	e, err := NewEncrypted(s, slog.New(slog.NewTextHandler(io.Discard, nil)), masters)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return e
+}
+
+func TestEncrypted(t *testing.T) {
+	inner := NewInMemory()
+	s := testEncrypted(t, inner, bytes.Repeat([]byte("a"), 32))
+
+	if _, err := s.Set(context.TODO(), "alice.0-secret", Item{Value: []byte("plaintext")}); err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := inner.Get(context.TODO(), "alice.0-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if raw.KeyVersion != 1 || bytes.Contains(raw.Value, []byte("plaintext")) {
+		t.Errorf("stored value was not encrypted")
+	}
+
+	e, err := s.Get(context.TODO(), "alice.0-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(e.Value) != "plaintext" {
+		t.Errorf("Get() = %s, want plaintext", e.Value)
+	}
+
+	// a value copied to another subject cannot be decrypted
+	if _, err := inner.Set(context.TODO(), "bob.0-secret", raw.Item); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Get(context.TODO(), "bob.0-secret"); err == nil {
+		t.Error("Get() error = nil, want an error for a value of another subject")
+	}
+
+	// written before encryption was enabled
+	if _, err := inner.Set(context.TODO(), "alice.0-legacy", Item{Value: []byte("legacy")}); err != nil {
+		t.Fatal(err)
+	}
+	e, err = s.Get(context.TODO(), "alice.0-legacy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(e.Value) != "legacy" {
+		t.Errorf("Get() = %s, want legacy", e.Value)
+	}
+}
+
+func TestEncrypted_Increment(t *testing.T) {
+	s := testEncrypted(t, NewInMemory(), bytes.Repeat([]byte("a"), 32))
+
+	for i := 0; i < 3; i++ {
+		if _, _, err := s.Increment(context.TODO(), "alice.0-counter", 2, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	v, _, err := s.Increment(context.TODO(), "alice.0-counter", -1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 5 {
+		t.Errorf("Increment() = %v, want 5", v)
+	}
+}
+
+func TestEncrypted_Reencrypt(t *testing.T) {
+	inner := NewInMemory()
+	first, second := bytes.Repeat([]byte("a"), 32), bytes.Repeat([]byte("b"), 32)
+
+	old := testEncrypted(t, inner, first)
+	for _, key := range []string{"alice.0-a", "bob.0-b"} {
+		if _, err := old.Set(context.TODO(), key, Item{Value: []byte(key)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := testEncrypted(t, inner, first, second)
+
+	// the old key is still readable before the values are rewritten
+	e, err := s.Get(context.TODO(), "alice.0-a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(e.Value) != "alice.0-a" {
+		t.Errorf("Get() = %s, want alice.0-a", e.Value)
+	}
+
+	n, err := s.Reencrypt(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("Reencrypt() = %v, want 2", n)
+	}
+
+	for _, key := range []string{"alice.0-a", "bob.0-b"} {
+		raw, err := inner.Get(context.TODO(), key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if raw.KeyVersion != 2 {
+			t.Errorf("KeyVersion = %v, want 2", raw.KeyVersion)
+		}
+
+		e, err := s.Get(context.TODO(), key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(e.Value) != key {
+			t.Errorf("Get() = %s, want %s", e.Value, key)
+		}
+	}
+
+	if n, _ := s.Reencrypt(context.TODO()); n != 0 {
+		t.Errorf("Reencrypt() = %v, want 0 once every value uses the newest key", n)
+	}
+}
+
+func TestEncrypted_Objects(t *testing.T) {
+	inner := NewInMemoryObjects()
+	o := testEncrypted(t, NewInMemory(), bytes.Repeat([]byte("a"), 32)).Objects(inner)
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: nil},
+		{name: "single segment", data: []byte("plaintext")},
+		{name: "full segments", data: bytes.Repeat([]byte("a"), objectSegmentSize*2)},
+		{name: "partial segment", data: bytes.Repeat([]byte("plaintext"), objectSegmentSize/4)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name := "alice.0-" + tt.name + "/1"
+
+			size, err := o.Put(context.TODO(), name, bytes.NewReader(tt.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if size != uint64(len(tt.data)) {
+				t.Errorf("Put() = %d, want %d", size, len(tt.data))
+			}
+
+			raw := readObject(t, inner, name)
+			if len(tt.data) > 0 && bytes.Contains(raw, tt.data[:min(len(tt.data), 64)]) {
+				t.Error("stored object was not encrypted")
+			}
+
+			if got := readObject(t, o, name); !bytes.Equal(got, tt.data) {
+				t.Errorf("Get() returned %d bytes, want %d", len(got), len(tt.data))
+			}
+		})
+	}
+
+	raw := readObject(t, inner, "alice.0-partial segment/1")
+
+	// an object copied to another subject cannot be decrypted
+	if _, err := inner.Put(context.TODO(), "bob.0-copy/1", bytes.NewReader(raw)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readObjectErr(o, "bob.0-copy/1"); err == nil {
+		t.Error("Get() error = nil, want an error for an object of another subject")
+	}
+
+	// a truncated object is an error rather than a shorter value
+	if _, err := inner.Put(context.TODO(), "alice.0-truncated/1", bytes.NewReader(raw[:len(raw)/2])); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readObjectErr(o, "alice.0-truncated/1"); err == nil {
+		t.Error("Get() error = nil, want an error for a truncated object")
+	}
+
+	// written before encryption was enabled
+	if _, err := inner.Put(context.TODO(), "alice.0-legacy/1", bytes.NewReader([]byte("legacy"))); err != nil {
+		t.Fatal(err)
+	}
+	if got := readObject(t, o, "alice.0-legacy/1"); string(got) != "legacy" {
+		t.Errorf("Get() = %s, want legacy", got)
+	}
+}
+
+func readObject(t *testing.T, o ObjectStore, name string) []byte {
+	t.Helper()
+
+	b, err := readObjectErr(o, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return b
+}
+
+func readObjectErr(o ObjectStore, name string) ([]byte, error) {
+	r, err := o.Get(context.TODO(), name)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return io.ReadAll(r)
+}
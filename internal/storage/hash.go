@@ -0,0 +1,165 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+)
+
+// Hash is a set of fields stored as a single key. The fields share the ttl of the key and every change
+// is written using the revision the hash was read at so concurrent writers never lose a field.
+type Hash map[string][]byte
+
+// hash decodes the hash stored in the entry, a nil entry is an empty hash.
+func hash(e *Entry) (Hash, error) {
+	h := make(Hash)
+	if e == nil || len(e.Value) == 0 {
+		return h, nil
+	}
+
+	if err := json.Unmarshal(e.Value, &h); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// item encodes the hash into an item with the ttl.
+func (h Hash) item(ttl int64) (Item, error) {
+	b, err := json.Marshal(h)
+	if err != nil {
+		return Item{}, err
+	}
+
+	return Item{Value: b, TTL: ttl}, nil
+}
+
+// HashGet returns the fields of the hash and the ttl of the hash. If the hash does not exist the hash is
+// empty.
+func HashGet(ctx context.Context, s Store, key string) (Hash, int64, error) {
+	e, err := s.Get(ctx, key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	h, err := hash(e)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if e == nil {
+		return h, 0, nil
+	}
+
+	return h, e.TTL, nil
+}
+
+// HashSet stores the fields in the hash and returns the number of fields that were added and the ttl of
+// the hash. If the ttl is not 0 it replaces the ttl of the hash, otherwise the hash keeps its ttl.
+func HashSet(ctx context.Context, s Store, key string, fields map[string][]byte, ttl int64) (int, int64, error) {
+	var added int
+	var expires int64
+
+	_, err := Mutate(ctx, s, key, func(e *Entry) (Item, error) {
+		h, err := hash(e)
+		if err != nil {
+			return Item{}, err
+		}
+
+		added = 0
+		for f, v := range fields {
+			if _, ok := h[f]; !ok {
+				added++
+			}
+
+			h[f] = v
+		}
+
+		expires = ttl
+		if expires == 0 && e != nil {
+			expires = e.TTL
+		}
+
+		return h.item(expires)
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return added, expires, nil
+}
+
+// HashDelete removes the fields from the hash and returns the number of fields that were removed. When
+// the last field is removed the hash is expired so it is removed from the store.
+func HashDelete(ctx context.Context, s Store, key string, fields []string) (int, error) {
+	var removed int
+
+	_, err := Mutate(ctx, s, key, func(e *Entry) (Item, error) {
+		if e == nil {
+			return Item{}, errNoChange
+		}
+
+		h, err := hash(e)
+		if err != nil {
+			return Item{}, err
+		}
+
+		removed = 0
+		for _, f := range fields {
+			if _, ok := h[f]; ok {
+				delete(h, f)
+				removed++
+			}
+		}
+
+		if removed == 0 {
+			return Item{}, errNoChange
+		}
+
+		if len(h) == 0 {
+			return Item{TTL: time.Now().Add(-time.Second).Unix()}, nil
+		}
+
+		return h.item(e.TTL)
+	})
+	if err != nil && !errors.Is(err, errNoChange) {
+		return 0, err
+	}
+
+	return removed, nil
+}
+
+// HashIncrement adds the delta to the integer stored in the field of the hash and returns the new value
+// and the ttl of the hash. If the hash does not exist it is created with the ttl.
+func HashIncrement(ctx context.Context, s Store, key, field string, delta int64, ttl int64) (int64, int64, error) {
+	var value int64
+	var expires int64
+
+	_, err := Mutate(ctx, s, key, func(e *Entry) (Item, error) {
+		h, err := hash(e)
+		if err != nil {
+			return Item{}, err
+		}
+
+		value, err = incr(h[field], delta)
+		if err != nil {
+			return Item{}, err
+		}
+
+		h[field] = []byte(strconv.FormatInt(value, 10))
+
+		expires = ttl
+		if e != nil {
+			expires = e.TTL
+		}
+
+		return h.item(expires)
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return value, expires, nil
+}
@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestHashSet(t *testing.T) {
+	s := NewInMemory()
+
+	added, _, err := HashSet(context.TODO(), s, "test", map[string][]byte{"a": []byte("1"), "b": []byte("2")}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added != 2 {
+		t.Errorf("HashSet() added = %v, want %v", added, 2)
+	}
+
+	added, _, err = HashSet(context.TODO(), s, "test", map[string][]byte{"b": []byte("3"), "c": []byte("4")}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added != 1 {
+		t.Errorf("HashSet() added = %v, want %v", added, 1)
+	}
+
+	h, _, err := HashGet(context.TODO(), s, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h) != 3 || string(h["a"]) != "1" || string(h["b"]) != "3" || string(h["c"]) != "4" {
+		t.Errorf("HashGet() = %v, want a=1 b=3 c=4", h)
+	}
+}
+
+func TestHashSet_concurrent(t *testing.T) {
+	s := NewInMemory()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			if _, _, err := HashSet(context.TODO(), s, "test", map[string][]byte{fmt.Sprint(i): []byte("v")}, 0); err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	h, _, err := HashGet(context.TODO(), s, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h) != 50 {
+		t.Errorf("HashGet() has %v fields, want %v", len(h), 50)
+	}
+}
+
+func TestHashDelete(t *testing.T) {
+	s := NewInMemory()
+
+	if _, _, err := HashSet(context.TODO(), s, "test", map[string][]byte{"a": []byte("1"), "b": []byte("2")}, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	removed, err := HashDelete(context.TODO(), s, "test", []string{"a", "missing"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removed != 1 {
+		t.Errorf("HashDelete() = %v, want %v", removed, 1)
+	}
+
+	if _, err := HashDelete(context.TODO(), s, "test", []string{"b"}); err != nil {
+		t.Fatal(err)
+	}
+
+	e, err := s.Get(context.TODO(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e != nil {
+		t.Errorf("Get() = %v, want nil after the last field was removed", e)
+	}
+}
+
+func TestHashIncrement(t *testing.T) {
+	s := NewInMemory()
+
+	if _, _, err := HashIncrement(context.TODO(), s, "test", "count", 5, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	v, _, err := HashIncrement(context.TODO(), s, "test", "count", -2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 3 {
+		t.Errorf("HashIncrement() = %v, want %v", v, 3)
+	}
+
+	if _, _, err := HashSet(context.TODO(), s, "test", map[string][]byte{"name": []byte("test")}, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := HashIncrement(context.TODO(), s, "test", "name", 1, 0); err != ErrNotInteger {
+		t.Errorf("HashIncrement() error = %v, want %v", err, ErrNotInteger)
+	}
+}
@@ -3,12 +3,102 @@ package storage
 import (
 	"context"
 	"encoding/json"
+	"sort"
+	"strconv"
 	"sync"
+	"time"
 )
 
 type inMemory struct {
 	mu sync.RWMutex
 	db map[string][]byte
+	// revisions holds the revision of each key in db, revision is the latest revision of the store
+	revisions map[string]uint64
+	revision  uint64
+	// created holds the time each key in db was stored
+	created  map[string]time.Time
+	watchers []*memoryWatcher
+}
+
+type memoryWatcher struct {
+	key    string
+	prefix bool
+	events chan Event
+}
+
+// watcherBuffer is the number of events buffered for each watcher, events are dropped for watchers
+// that fall further behind so writers are never blocked
+const watcherBuffer = 256
+
+// notify sends the event to every matching watcher, the caller must hold the lock.
+func (s *inMemory) notify(e Event) {
+	for _, w := range s.watchers {
+		if !matches(e.Key, w.key, w.prefix) {
+			continue
+		}
+
+		select {
+		case w.events <- e:
+		default:
+		}
+	}
+}
+
+// entry returns the entry for the key including expired items, the caller must hold the lock.
+func (s *inMemory) entry(key string) (*Entry, error) {
+	b, ok := s.db[key]
+	if !ok {
+		return nil, nil
+	}
+
+	var i Item
+	if err := json.Unmarshal(b, &i); err != nil {
+		return nil, err
+	}
+
+	return &Entry{Item: i, Revision: s.revisions[key]}, nil
+}
+
+// put stores the item and returns the new revision, the caller must hold the lock.
+func (s *inMemory) put(key string, i Item) (uint64, error) {
+	b, err := json.Marshal(i)
+	if err != nil {
+		return 0, err
+	}
+
+	if s.revisions == nil {
+		s.revisions = make(map[string]uint64)
+	}
+
+	if s.created == nil {
+		s.created = make(map[string]time.Time)
+	}
+
+	s.revision++
+	s.db[key] = b
+	s.revisions[key] = s.revision
+	s.created[key] = time.Now()
+
+	s.notify(Event{Item: i, Key: key, Operation: OperationPut, Revision: s.revision})
+
+	return s.revision, nil
+}
+
+// Create implements Store.
+func (s *inMemory) Create(ctx context.Context, key string, i Item) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.entry(key)
+	if err != nil {
+		return 0, err
+	}
+
+	if e != nil && !e.IsExpired() {
+		return 0, ErrKeyExists
+	}
+
+	return s.put(key, i)
 }
 
 // Delete implements Store.
@@ -21,71 +111,256 @@ func (s *inMemory) Delete(ctx context.Context, key string) error {
 	}
 
 	delete(s.db, key)
+	delete(s.revisions, key)
+	delete(s.created, key)
+
+	s.revision++
+	s.notify(Event{Key: key, Operation: OperationDelete, Revision: s.revision})
 
 	return nil
 }
 
 // Get implements Store.
-func (s *inMemory) Get(ctx context.Context, key string) ([]byte, int64, error) {
+func (s *inMemory) Get(ctx context.Context, key string) (*Entry, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	if _, ok := s.db[key]; !ok {
-		return nil, 0, nil
+	e, err := s.entry(key)
+	if err != nil {
+		return nil, err
 	}
 
-	var i Item
-	if err := json.Unmarshal(s.db[key], &i); err != nil {
+	// check if the item has expired
+	if e == nil || e.IsExpired() {
+		return nil, nil
+	}
+
+	return e, nil
+}
+
+// GetRevision implements Store. Only the latest revision of a key is kept in memory.
+func (s *inMemory) GetRevision(ctx context.Context, key string, revision uint64) (*Entry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	e, err := s.entry(key)
+	if err != nil || e == nil || e.Revision != revision {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// GetAndDelete implements Store.
+func (s *inMemory) GetAndDelete(ctx context.Context, key string) (*Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.entry(key)
+	if err != nil || e == nil {
+		return nil, err
+	}
+
+	delete(s.db, key)
+	delete(s.revisions, key)
+	delete(s.created, key)
+
+	s.revision++
+	s.notify(Event{Key: key, Operation: OperationDelete, Revision: s.revision})
+
+	if e.IsExpired() {
+		return nil, nil
+	}
+
+	return e, nil
+}
+
+// GetAndSet implements Store.
+func (s *inMemory) GetAndSet(ctx context.Context, key string, i Item) (*Entry, uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.entry(key)
+	if err != nil {
 		return nil, 0, err
 	}
 
-	// check if the item has expired
-	if i.IsExpired() {
-		return nil, 0, nil
+	revision, err := s.put(key, i)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	return i.Value, i.TTL, nil
+	if e == nil || e.IsExpired() {
+		return nil, revision, nil
+	}
+
+	return e, revision, nil
 }
 
-// Set implements Store.
-func (s *inMemory) Set(ctx context.Context, key string, value []byte, ttl int64) error {
+// History implements Store. Only the latest revision of a key is kept in memory.
+func (s *inMemory) History(ctx context.Context, key string) ([]Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	e, err := s.entry(key)
+	if err != nil || e == nil {
+		return nil, err
+	}
+
+	return []Event{{
+		Item:      e.Item,
+		Key:       key,
+		Operation: OperationPut,
+		Revision:  e.Revision,
+		Created:   s.created[key],
+	}}, nil
+}
+
+// Increment implements Store.
+func (s *inMemory) Increment(ctx context.Context, key string, delta int64, ttl int64) (int64, int64, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	i := Item{
-		Value: value,
-		TTL:   ttl,
+	i := Item{TTL: ttl}
+
+	e, err := s.entry(key)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// an expired key is treated as if it does not exist
+	if e != nil && !e.IsExpired() {
+		i = e.Item
 	}
 
-	b, err := json.Marshal(i)
+	v, err := incr(i.Value, delta)
 	if err != nil {
-		return err
+		return 0, 0, err
 	}
 
-	s.db[key] = b
+	i.Value = []byte(strconv.FormatInt(v, 10))
 
-	return nil
+	if _, err := s.put(key, i); err != nil {
+		return 0, 0, err
+	}
+
+	return v, i.TTL, nil
+}
+
+// Touch implements Store.
+func (s *inMemory) Touch(ctx context.Context, key string, ttl int64) (uint64, error) {
+	return touch(ctx, s, key, ttl)
+}
+
+// Set implements Store.
+func (s *inMemory) Set(ctx context.Context, key string, i Item) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.put(key, i)
+}
+
+// Update implements Store.
+func (s *inMemory) Update(ctx context.Context, key string, i Item, revision uint64) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var current uint64
+	if _, ok := s.db[key]; ok {
+		current = s.revisions[key]
+	}
+
+	if current != revision {
+		return 0, ErrRevisionMismatch
+	}
+
+	return s.put(key, i)
 }
 
+// Purge implements Store.
 func (s *inMemory) Purge(ctx context.Context, prefix string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	for k := range s.db {
-		if prefix != "" && k == prefix {
-			delete(s.db, k)
-		} else if prefix == "" {
-			delete(s.db, k)
+		if !matches(k, prefix, true) {
+			continue
 		}
+
+		delete(s.db, k)
+		delete(s.revisions, k)
+		delete(s.created, k)
+
+		s.revision++
+		s.notify(Event{Key: k, Operation: OperationDelete, Revision: s.revision})
 	}
 
 	return nil
 }
 
+// Keys implements Store.
+func (s *inMemory) Keys(ctx context.Context, prefix string) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var keys []string
+	for k := range s.db {
+		if !matches(k, prefix, true) {
+			continue
+		}
+
+		e, err := s.entry(k)
+		if err != nil {
+			return nil, err
+		}
+
+		if !e.IsExpired() {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys, nil
+}
+
+// Watch implements Store.
+func (s *inMemory) Watch(ctx context.Context, key string, prefix bool) (<-chan Event, error) {
+	w := &memoryWatcher{
+		key:    key,
+		prefix: prefix,
+		events: make(chan Event, watcherBuffer),
+	}
+
+	s.mu.Lock()
+	s.watchers = append(s.watchers, w)
+	s.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		for i, v := range s.watchers {
+			if v == w {
+				s.watchers = append(s.watchers[:i], s.watchers[i+1:]...)
+				break
+			}
+		}
+
+		close(w.events)
+	}()
+
+	return watchExpirations(ctx, w.events), nil
+}
+
 // NewInMemory returns a new in memory storage engine
 func NewInMemory() Store {
 	return &inMemory{
-		mu: sync.RWMutex{},
-		db: make(map[string][]byte),
+		mu:        sync.RWMutex{},
+		db:        make(map[string][]byte),
+		revisions: make(map[string]uint64),
+		created:   make(map[string]time.Time),
 	}
 }
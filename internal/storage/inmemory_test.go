@@ -3,15 +3,14 @@ package storage
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"reflect"
-	"sync"
 	"testing"
 	"time"
 )
 
 func Test_inMemory_Delete(t *testing.T) {
 	type fields struct {
-		mu sync.RWMutex
 		db map[string][]byte
 	}
 	type args struct {
@@ -26,7 +25,6 @@ func Test_inMemory_Delete(t *testing.T) {
 		{
 			name: "should delete the key",
 			fields: fields{
-				mu: sync.RWMutex{},
 				db: map[string][]byte{
 					"test": marshalItem(t, Item{
 						Value: []byte("test"),
@@ -42,7 +40,6 @@ func Test_inMemory_Delete(t *testing.T) {
 		{
 			name: "should not error if the key does not exist",
 			fields: fields{
-				mu: sync.RWMutex{},
 				db: map[string][]byte{},
 			},
 			args: args{
@@ -54,7 +51,6 @@ func Test_inMemory_Delete(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			s := &inMemory{
-				mu: tt.fields.mu,
 				db: tt.fields.db,
 			}
 			if err := s.Delete(context.TODO(), tt.args.key); (err != nil) != tt.wantErr {
@@ -76,7 +72,6 @@ func marshalItem(t *testing.T, i Item) []byte {
 
 func Test_inMemory_Get(t *testing.T) {
 	type fields struct {
-		mu sync.RWMutex
 		db map[string][]byte
 	}
 	type args struct {
@@ -92,7 +87,6 @@ func Test_inMemory_Get(t *testing.T) {
 		{
 			name: "should return the value",
 			fields: fields{
-				mu: sync.RWMutex{},
 				db: map[string][]byte{
 					"test": marshalItem(t, Item{
 						Value: []byte("test"),
@@ -109,7 +103,6 @@ func Test_inMemory_Get(t *testing.T) {
 		{
 			name: "should return nil if the key does not exist",
 			fields: fields{
-				mu: sync.RWMutex{},
 				db: map[string][]byte{},
 			},
 			args: args{
@@ -121,7 +114,6 @@ func Test_inMemory_Get(t *testing.T) {
 		{
 			name: "should return nil if the key has expired",
 			fields: fields{
-				mu: sync.RWMutex{},
 				db: map[string][]byte{
 					"test": marshalItem(t, Item{
 						Value: []byte("test"),
@@ -139,17 +131,410 @@ func Test_inMemory_Get(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			s := &inMemory{
-				mu: tt.fields.mu,
 				db: tt.fields.db,
 			}
-			got, err := s.Get(context.TODO(), tt.args.key)
+			e, err := s.Get(context.TODO(), tt.args.key)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("inMemory.Get() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			var got []byte
+			if e != nil {
+				got = e.Value
+			}
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("inMemory.Get() = %v, want %v", string(got), string(tt.want))
 			}
 		})
 	}
 }
+
+func Test_inMemory_Increment(t *testing.T) {
+	type fields struct {
+		db map[string][]byte
+	}
+	type args struct {
+		key   string
+		delta int64
+		ttl   int64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    int64
+		wantTTL int64
+		wantErr error
+	}{
+		{
+			name: "should create the key if it does not exist",
+			fields: fields{
+				db: map[string][]byte{},
+			},
+			args: args{
+				key:   "test",
+				delta: 5,
+				ttl:   100,
+			},
+			want:    5,
+			wantTTL: 100,
+		},
+		{
+			name: "should add the delta and keep the existing ttl",
+			fields: fields{
+				db: map[string][]byte{
+					"test": marshalItem(t, Item{
+						Value: []byte("10"),
+						TTL:   0,
+					}),
+				},
+			},
+			args: args{
+				key:   "test",
+				delta: -3,
+				ttl:   100,
+			},
+			want:    7,
+			wantTTL: 0,
+		},
+		{
+			name: "should reset an expired key",
+			fields: fields{
+				db: map[string][]byte{
+					"test": marshalItem(t, Item{
+						Value: []byte("10"),
+						TTL:   time.Now().Unix() - 20,
+					}),
+				},
+			},
+			args: args{
+				key:   "test",
+				delta: 1,
+			},
+			want: 1,
+		},
+		{
+			name: "should error if the value is not an integer",
+			fields: fields{
+				db: map[string][]byte{
+					"test": marshalItem(t, Item{
+						Value: []byte("test"),
+					}),
+				},
+			},
+			args: args{
+				key:   "test",
+				delta: 1,
+			},
+			wantErr: ErrNotInteger,
+		},
+		{
+			name: "should error if the value would overflow",
+			fields: fields{
+				db: map[string][]byte{
+					"test": marshalItem(t, Item{
+						Value: []byte("9223372036854775807"),
+					}),
+				},
+			},
+			args: args{
+				key:   "test",
+				delta: 1,
+			},
+			wantErr: ErrOverflow,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &inMemory{
+				db: tt.fields.db,
+			}
+			got, gotTTL, err := s.Increment(context.TODO(), tt.args.key, tt.args.delta, tt.args.ttl)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("inMemory.Increment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("inMemory.Increment() got = %v, want %v", got, tt.want)
+			}
+			if gotTTL != tt.wantTTL {
+				t.Errorf("inMemory.Increment() gotTTL = %v, want %v", gotTTL, tt.wantTTL)
+			}
+		})
+	}
+}
+
+func Test_inMemory_Create(t *testing.T) {
+	type fields struct {
+		db map[string][]byte
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		key     string
+		wantErr error
+	}{
+		{
+			name: "should create the key if it does not exist",
+			fields: fields{
+				db: map[string][]byte{},
+			},
+			key: "test",
+		},
+		{
+			name: "should replace an expired key",
+			fields: fields{
+				db: map[string][]byte{
+					"test": marshalItem(t, Item{
+						Value: []byte("test"),
+						TTL:   time.Now().Unix() - 20,
+					}),
+				},
+			},
+			key: "test",
+		},
+		{
+			name: "should error if the key exists",
+			fields: fields{
+				db: map[string][]byte{
+					"test": marshalItem(t, Item{
+						Value: []byte("test"),
+					}),
+				},
+			},
+			key:     "test",
+			wantErr: ErrKeyExists,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &inMemory{
+				db: tt.fields.db,
+			}
+			_, err := s.Create(context.TODO(), tt.key, Item{Value: []byte("new")})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("inMemory.Create() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_inMemory_Update(t *testing.T) {
+	s := NewInMemory()
+
+	revision, err := s.Set(context.TODO(), "test", Item{Value: []byte("first")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	next, err := s.Update(context.TODO(), "test", Item{Value: []byte("second")}, revision)
+	if err != nil {
+		t.Errorf("inMemory.Update() error = %v, wantErr %v", err, nil)
+	}
+	if next <= revision {
+		t.Errorf("inMemory.Update() revision = %v, want greater than %v", next, revision)
+	}
+
+	if _, err := s.Update(context.TODO(), "test", Item{Value: []byte("third")}, revision); !errors.Is(err, ErrRevisionMismatch) {
+		t.Errorf("inMemory.Update() error = %v, wantErr %v", err, ErrRevisionMismatch)
+	}
+
+	e, err := s.Get(context.TODO(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(e.Value) != "second" || e.Revision != next {
+		t.Errorf("inMemory.Get() = %v at %v, want %v at %v", string(e.Value), e.Revision, "second", next)
+	}
+}
+
+func Test_inMemory_Watch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	s := NewInMemory()
+
+	events, err := s.Watch(ctx, "test.0-a", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Set(ctx, "test.0-b", Item{Value: []byte("ignored")}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Set(ctx, "test.0-ab", Item{Value: []byte("test")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Delete(ctx, "test.0-ab"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Operation{OperationPut, OperationDelete}
+	for _, op := range want {
+		select {
+		case e := <-events:
+			if e.Key != "test.0-ab" || e.Operation != op {
+				t.Errorf("inMemory.Watch() got = %v %v, want %v %v", e.Key, e.Operation, "test.0-ab", op)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("inMemory.Watch() timed out waiting for %v", op)
+		}
+	}
+}
+
+func Test_inMemory_Keys(t *testing.T) {
+	s := &inMemory{
+		db: map[string][]byte{
+			"test.0-b":  marshalItem(t, Item{Value: []byte("test")}),
+			"test.0-a":  marshalItem(t, Item{Value: []byte("test")}),
+			"test.0-c":  marshalItem(t, Item{Value: []byte("test"), TTL: time.Now().Unix() - 20}),
+			"test.1-a":  marshalItem(t, Item{Value: []byte("test")}),
+			"other.0-a": marshalItem(t, Item{Value: []byte("test")}),
+		},
+	}
+
+	got, err := s.Keys(context.TODO(), "test.0-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"test.0-a", "test.0-b"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("inMemory.Keys() = %v, want %v", got, want)
+	}
+}
+
+func Test_inMemory_Touch(t *testing.T) {
+	s := NewInMemory()
+
+	if _, err := s.Touch(context.TODO(), "test", 100); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("inMemory.Touch() error = %v, wantErr %v", err, ErrKeyNotFound)
+	}
+
+	if _, err := s.Set(context.TODO(), "test", Item{Value: []byte("test")}); err != nil {
+		t.Fatal(err)
+	}
+
+	ttl := time.Now().Unix() + 60
+	if _, err := s.Touch(context.TODO(), "test", ttl); err != nil {
+		t.Errorf("inMemory.Touch() error = %v, wantErr %v", err, nil)
+	}
+
+	e, err := s.Get(context.TODO(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(e.Value) != "test" || e.TTL != ttl {
+		t.Errorf("inMemory.Get() = %v with ttl %v, want %v with ttl %v", string(e.Value), e.TTL, "test", ttl)
+	}
+
+	// an explicit ttl removes the sliding window
+	if _, err := s.Set(context.TODO(), "sliding", Item{Value: []byte("test"), TTL: ttl, Sliding: 60}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Touch(context.TODO(), "sliding", ttl+60); err != nil {
+		t.Fatal(err)
+	}
+
+	e, err = s.Get(context.TODO(), "sliding")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Sliding != 0 {
+		t.Errorf("inMemory.Touch() sliding = %d, want 0", e.Sliding)
+	}
+}
+
+func Test_inMemory_GetAndDelete(t *testing.T) {
+	type fields struct {
+		db map[string][]byte
+	}
+	type args struct {
+		key string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name: "should return and delete the key",
+			fields: fields{
+				db: map[string][]byte{
+					"test": marshalItem(t, Item{Value: []byte("test")}),
+				},
+			},
+			args: args{
+				key: "test",
+			},
+			want: []byte("test"),
+		},
+		{
+			name: "should not return an expired key",
+			fields: fields{
+				db: map[string][]byte{
+					"test": marshalItem(t, Item{Value: []byte("test"), TTL: time.Now().Add(-time.Minute).Unix()}),
+				},
+			},
+			args: args{
+				key: "test",
+			},
+		},
+		{
+			name: "should not error if the key does not exist",
+			fields: fields{
+				db: map[string][]byte{},
+			},
+			args: args{
+				key: "test",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &inMemory{
+				db: tt.fields.db,
+			}
+			got, err := s.GetAndDelete(context.TODO(), tt.args.key)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("inMemory.GetAndDelete() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if (got == nil) != (tt.want == nil) || (got != nil && !reflect.DeepEqual(got.Value, tt.want)) {
+				t.Errorf("inMemory.GetAndDelete() = %v, want %v", got, tt.want)
+			}
+			if _, ok := s.db[tt.args.key]; ok {
+				t.Errorf("inMemory.GetAndDelete() did not delete the key")
+			}
+		})
+	}
+}
+
+func Test_inMemory_GetRevision(t *testing.T) {
+	s := NewInMemory()
+
+	first, err := s.Set(context.TODO(), "test", Item{Value: []byte("first")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	latest, err := s.Set(context.TODO(), "test", Item{Value: []byte("latest")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if e, err := s.GetRevision(context.TODO(), "test", first); err != nil || e != nil {
+		t.Errorf("inMemory.GetRevision() = %v, %v, want nil since only the latest revision is kept", e, err)
+	}
+
+	e, err := s.GetRevision(context.TODO(), "test", latest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e == nil || string(e.Value) != "latest" {
+		t.Errorf("inMemory.GetRevision() = %v, want %v", e, "latest")
+	}
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log/slog"
+	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
 )
 
@@ -15,106 +19,405 @@ type natsKeyValue struct {
 	logger *slog.Logger
 }
 
-func (n *natsKeyValue) Get(ctx context.Context, key string) ([]byte, int64, error) {
+// entry returns the entry for the key including expired items or nil if the key does not exist.
+func (n *natsKeyValue) entry(ctx context.Context, key string) (*Entry, error) {
 	v, err := n.bucket.Get(ctx, key)
 	if err != nil {
 		if errors.Is(err, jetstream.ErrKeyNotFound) {
 			n.logger.InfoContext(ctx, "key not found", "key", key)
 
-			return nil, 0, nil
+			return nil, nil
 		}
 
 		n.logger.ErrorContext(ctx, "failed to get key", "key", key, "error", err.Error())
 
-		return nil, 0, err
+		return nil, err
 	}
 
 	var i Item
 	if err := json.Unmarshal(v.Value(), &i); err != nil {
 		n.logger.ErrorContext(ctx, "failed to unmarshal item", "key", key, "error", err.Error())
 
-		return nil, 0, err
+		return nil, err
+	}
+
+	return &Entry{Item: i, Revision: v.Revision()}, nil
+}
+
+func (n *natsKeyValue) Get(ctx context.Context, key string) (*Entry, error) {
+	e, err := n.entry(ctx, key)
+	if err != nil || e == nil {
+		return nil, err
 	}
 
 	// check if the item has expired
-	if i.IsExpired() {
-		n.logger.InfoContext(ctx, "key expired", "key", key, "ttl", i.TTL)
+	if e.IsExpired() {
+		n.logger.InfoContext(ctx, "key expired", "key", key, "ttl", e.TTL)
 
-		defer n.purgeKey(ctx, key)
+		defer n.purgeKey(ctx, key, e.Revision)
 
-		return nil, 0, nil
+		return nil, nil
 	}
 
-	n.logger.InfoContext(ctx, "got key", "key", key, "ttl", i.TTL)
+	n.logger.InfoContext(ctx, "got key", "key", key, "ttl", e.TTL, "revision", e.Revision)
 
-	return i.Value, i.TTL, nil
+	return e, nil
 }
 
-func (n *natsKeyValue) purgeKey(ctx context.Context, keys ...string) error {
-	for _, k := range keys {
-		if err := n.bucket.Purge(ctx, k); err != nil {
-			n.logger.ErrorContext(ctx, "failed to purge key", "key", k, "error", err.Error())
-			continue
+// GetRevision reads the message stored at the revision, JetStream checks the message belongs to the key.
+func (n *natsKeyValue) GetRevision(ctx context.Context, key string, revision uint64) (*Entry, error) {
+	v, err := n.bucket.GetRevision(ctx, key, revision)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			n.logger.InfoContext(ctx, "revision not found", "key", key, "revision", revision)
+
+			return nil, nil
 		}
 
-		n.logger.InfoContext(ctx, "purged key", "key", k)
+		n.logger.ErrorContext(ctx, "failed to get revision", "key", key, "revision", revision, "error", err.Error())
+
+		return nil, err
 	}
 
-	return nil
+	var i Item
+	if err := json.Unmarshal(v.Value(), &i); err != nil {
+		n.logger.ErrorContext(ctx, "failed to unmarshal item", "key", key, "error", err.Error())
+
+		return nil, err
+	}
+
+	return &Entry{Item: i, Revision: v.Revision()}, nil
+}
+
+// History returns the revisions of the key kept by the bucket, the number of revisions is limited by
+// the history configured for the bucket.
+func (n *natsKeyValue) History(ctx context.Context, key string) ([]Event, error) {
+	values, err := n.bucket.History(ctx, key)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return nil, nil
+		}
+
+		n.logger.ErrorContext(ctx, "failed to get history", "key", key, "error", err.Error())
+
+		return nil, err
+	}
+
+	events := make([]Event, 0, len(values))
+	for _, v := range values {
+		e, err := event(v)
+		if err != nil {
+			n.logger.ErrorContext(ctx, "failed to unmarshal item", "key", key, "error", err.Error())
+
+			return nil, err
+		}
+
+		e.Created = v.Created()
+		events = append(events, e)
+	}
+
+	return events, nil
+}
+
+// GetAndDelete deletes the key using the revision it was read at, if another client modified or
+// deleted the key since it was read the delete is rejected by JetStream and the key is read again.
+func (n *natsKeyValue) GetAndDelete(ctx context.Context, key string) (*Entry, error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		e, err := n.entry(ctx, key)
+		if err != nil || e == nil {
+			return nil, err
+		}
+
+		if e.IsExpired() {
+			n.logger.InfoContext(ctx, "key expired", "key", key, "ttl", e.TTL)
+
+			defer n.purgeKey(ctx, key, e.Revision)
+
+			return nil, nil
+		}
+
+		if err := n.bucket.Delete(ctx, key, jetstream.LastRevision(e.Revision)); err != nil {
+			if errors.Is(err, jetstream.ErrKeyExists) {
+				n.logger.DebugContext(ctx, "key was modified, retrying get and delete", "key", key)
+
+				continue
+			}
+
+			n.logger.ErrorContext(ctx, "failed to delete key", "key", key, "error", err.Error())
+
+			return nil, err
+		}
+
+		n.logger.InfoContext(ctx, "got and deleted key", "key", key, "revision", e.Revision)
+
+		return e, nil
+	}
+}
+
+// GetAndSet stores the item using the revision the previous entry was read at, if another client
+// modified the key since it was read the key is read again.
+func (n *natsKeyValue) GetAndSet(ctx context.Context, key string, i Item) (*Entry, uint64, error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, 0, err
+		}
+
+		e, err := n.entry(ctx, key)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var revision uint64
+		if e == nil {
+			revision, err = n.Create(ctx, key, i)
+		} else {
+			revision, err = n.Update(ctx, key, i, e.Revision)
+		}
+		if err != nil {
+			if errors.Is(err, ErrKeyExists) || errors.Is(err, ErrRevisionMismatch) {
+				n.logger.DebugContext(ctx, "key was modified, retrying get and set", "key", key)
+
+				continue
+			}
+
+			return nil, 0, err
+		}
+
+		// an expired key is treated as if it did not exist
+		if e != nil && e.IsExpired() {
+			e = nil
+		}
+
+		return e, revision, nil
+	}
+}
+
+// Increment uses the revision of the entry to update the value, if another client updated the key
+// since it was read the update is rejected by JetStream and the increment is retried.
+func (n *natsKeyValue) Increment(ctx context.Context, key string, delta int64, ttl int64) (int64, int64, error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return 0, 0, err
+		}
+
+		i := Item{TTL: ttl}
+
+		e, err := n.entry(ctx, key)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		// an expired key is treated as if it does not exist
+		var revision uint64
+		if e != nil {
+			if !e.IsExpired() {
+				i = e.Item
+			}
+
+			revision = e.Revision
+		}
+
+		value, err := incr(i.Value, delta)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		i.Value = []byte(strconv.FormatInt(value, 10))
+
+		b, err := json.Marshal(i)
+		if err != nil {
+			n.logger.ErrorContext(ctx, "failed to marshal item", "key", key, "error", err.Error())
+
+			return 0, 0, err
+		}
+
+		if revision == 0 {
+			_, err = n.bucket.Create(ctx, key, b)
+		} else {
+			_, err = n.bucket.Update(ctx, key, b, revision)
+		}
+		if err != nil {
+			if errors.Is(err, jetstream.ErrKeyExists) {
+				n.logger.DebugContext(ctx, "key was modified, retrying increment", "key", key)
+
+				continue
+			}
+
+			n.logger.ErrorContext(ctx, "failed to increment key", "key", key, "error", err.Error())
+
+			return 0, 0, err
+		}
+
+		n.logger.InfoContext(ctx, "incremented key", "key", key, "delta", delta, "value", value)
+
+		return value, i.TTL, nil
+	}
 }
 
-func (n *natsKeyValue) Set(ctx context.Context, key string, value []byte, ttl int64) error {
-	i := Item{
-		Value: value,
-		TTL:   ttl, // this should already be in unix time if its more than 0
+// purgeKey removes the key only if it was not written since the revision, this prevents removing
+// a key that was created by another client after it expired.
+func (n *natsKeyValue) purgeKey(ctx context.Context, key string, revision uint64) error {
+	if err := n.bucket.Purge(ctx, key, jetstream.LastRevision(revision)); err != nil {
+		n.logger.ErrorContext(ctx, "failed to purge key", "key", key, "error", err.Error())
+
+		return err
 	}
 
+	n.logger.InfoContext(ctx, "purged key", "key", key)
+
+	return nil
+}
+
+func (n *natsKeyValue) Set(ctx context.Context, key string, i Item) (uint64, error) {
 	b, err := json.Marshal(i)
 	if err != nil {
 		n.logger.ErrorContext(ctx, "failed to marshal item", "key", key, "error", err.Error())
 
-		return err
+		return 0, err
 	}
 
-	if _, err := n.bucket.Put(ctx, key, b); err != nil {
+	revision, err := n.bucket.Put(ctx, key, b)
+	if err != nil {
 		n.logger.ErrorContext(ctx, "failed to set key", "key", key, "error", err.Error())
-		return err
+		return 0, putError(err)
 	}
 
-	n.logger.InfoContext(ctx, "set key", "key", key, "ttl", ttl)
+	n.logger.InfoContext(ctx, "set key", "key", key, "ttl", i.TTL, "revision", revision)
 
-	return nil
+	return revision, nil
 }
 
-func (n *natsKeyValue) Purge(ctx context.Context, prefix string) error {
-	keys, err := n.bucket.Keys(ctx)
+// Touch uses the revision of the entry to update the ttl so the value is never overwritten by a stale copy.
+func (n *natsKeyValue) Touch(ctx context.Context, key string, ttl int64) (uint64, error) {
+	revision, err := touch(ctx, n, key, ttl)
+	if err != nil {
+		return 0, err
+	}
+
+	n.logger.InfoContext(ctx, "touched key", "key", key, "ttl", ttl, "revision", revision)
+
+	return revision, nil
+}
+
+// Create uses the JetStream create which fails if the key exists, since an expired item is still
+// stored in the bucket it is replaced using the revision of the expired item.
+func (n *natsKeyValue) Create(ctx context.Context, key string, i Item) (uint64, error) {
+	b, err := json.Marshal(i)
+	if err != nil {
+		n.logger.ErrorContext(ctx, "failed to marshal item", "key", key, "error", err.Error())
+
+		return 0, err
+	}
+
+	revision, err := n.bucket.Create(ctx, key, b)
+	if err == nil {
+		n.logger.InfoContext(ctx, "created key", "key", key, "ttl", i.TTL, "revision", revision)
+
+		return revision, nil
+	}
+
+	if !errors.Is(err, jetstream.ErrKeyExists) {
+		n.logger.ErrorContext(ctx, "failed to create key", "key", key, "error", err.Error())
+
+		return 0, putError(err)
+	}
+
+	e, err := n.entry(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+
+	if e != nil && !e.IsExpired() {
+		return 0, ErrKeyExists
+	}
+
+	var current uint64
+	if e != nil {
+		current = e.Revision
+	}
+
+	revision, err = n.Update(ctx, key, i, current)
+	if errors.Is(err, ErrRevisionMismatch) {
+		return 0, ErrKeyExists
+	}
+
+	return revision, err
+}
+
+func (n *natsKeyValue) Update(ctx context.Context, key string, i Item, revision uint64) (uint64, error) {
+	b, err := json.Marshal(i)
 	if err != nil {
-		n.logger.ErrorContext(ctx, "failed to get keys", "error", err.Error())
+		n.logger.ErrorContext(ctx, "failed to marshal item", "key", key, "error", err.Error())
+
+		return 0, err
+	}
+
+	revision, err = n.bucket.Update(ctx, key, b, revision)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyExists) {
+			n.logger.InfoContext(ctx, "revision mismatch", "key", key)
+
+			return 0, ErrRevisionMismatch
+		}
 
+		n.logger.ErrorContext(ctx, "failed to update key", "key", key, "error", err.Error())
+
+		return 0, putError(err)
+	}
+
+	n.logger.InfoContext(ctx, "updated key", "key", key, "ttl", i.TTL, "revision", revision)
+
+	return revision, nil
+}
+
+func (n *natsKeyValue) Purge(ctx context.Context, prefix string) error {
+	keys, err := n.Keys(ctx, prefix)
+	if err != nil {
 		return err
 	}
 
 	for _, key := range keys {
-		if prefix != "" {
-			if strings.HasPrefix(key, prefix) {
-				if err := n.bucket.Delete(ctx, key); err != nil {
-					n.logger.ErrorContext(ctx, "failed to delete key", "key", key, "error", err.Error())
-
-					return err
-				}
-			}
-		} else {
-			if err := n.bucket.Delete(ctx, key); err != nil {
-				n.logger.ErrorContext(ctx, "failed to delete key", "key", key, "error", err.Error())
+		if err := n.bucket.Delete(ctx, key); err != nil {
+			n.logger.ErrorContext(ctx, "failed to delete key", "key", key, "error", err.Error())
 
-				return err
-			}
+			return err
 		}
 	}
 
 	return nil
 }
 
+// Keys only reads the keys in the subject tokens of the prefix instead of every key in the bucket.
+func (n *natsKeyValue) Keys(ctx context.Context, prefix string) ([]string, error) {
+	w, err := n.bucket.Watch(ctx, pattern(prefix, true), jetstream.IgnoreDeletes(), jetstream.MetaOnly())
+	if err != nil {
+		n.logger.ErrorContext(ctx, "failed to get keys", "prefix", prefix, "error", err.Error())
+
+		return nil, err
+	}
+	defer w.Stop()
+
+	var keys []string
+	for v := range w.Updates() {
+		// the initial values have been received
+		if v == nil {
+			break
+		}
+
+		if matches(v.Key(), prefix, true) {
+			keys = append(keys, v.Key())
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys, nil
+}
+
 func (n *natsKeyValue) Delete(ctx context.Context, key string) error {
 	if err := n.bucket.Delete(ctx, key); err != nil {
 		n.logger.ErrorContext(ctx, "failed to delete key", "key", key, "error", err.Error())
@@ -127,6 +430,98 @@ func (n *natsKeyValue) Delete(ctx context.Context, key string) error {
 	return nil
 }
 
+// pattern returns the subject to watch for the key. NATS wildcards only match entire tokens so a
+// prefix is watched using the complete tokens of the prefix and keys must still be matched.
+func pattern(key string, prefix bool) string {
+	if !prefix {
+		return key
+	}
+
+	if i := strings.LastIndex(key, "."); i >= 0 {
+		return key[:i] + ".>"
+	}
+
+	return ">"
+}
+
+// Watch subscribes to the key, or every key in the subject tokens of the prefix, and only sends events
+// for keys that match.
+func (n *natsKeyValue) Watch(ctx context.Context, key string, prefix bool) (<-chan Event, error) {
+	w, err := n.bucket.Watch(ctx, pattern(key, prefix), jetstream.UpdatesOnly())
+	if err != nil {
+		n.logger.ErrorContext(ctx, "failed to watch key", "key", key, "error", err.Error())
+
+		return nil, err
+	}
+
+	n.logger.InfoContext(ctx, "watching key", "key", key, "prefix", prefix)
+
+	events := make(chan Event)
+
+	go func() {
+		defer close(events)
+		defer w.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-w.Updates():
+				if !ok {
+					return
+				}
+
+				if v == nil || !matches(v.Key(), key, prefix) {
+					continue
+				}
+
+				e, err := event(v)
+				if err != nil {
+					n.logger.ErrorContext(ctx, "failed to unmarshal item", "key", v.Key(), "error", err.Error())
+					continue
+				}
+
+				select {
+				case events <- e:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return watchExpirations(ctx, events), nil
+}
+
+// event converts the JetStream entry into an event, the item is only decoded for puts.
+func event(v jetstream.KeyValueEntry) (Event, error) {
+	e := Event{Key: v.Key(), Revision: v.Revision()}
+
+	switch v.Operation() {
+	case jetstream.KeyValueDelete:
+		e.Operation = OperationDelete
+	case jetstream.KeyValuePurge:
+		e.Operation = OperationPurge
+	default:
+		e.Operation = OperationPut
+
+		if err := json.Unmarshal(v.Value(), &e.Item); err != nil {
+			return Event{}, err
+		}
+	}
+
+	return e, nil
+}
+
+// putError returns ErrValueTooLarge if the item was larger than the max payload of the server.
+func putError(err error) error {
+	if errors.Is(err, nats.ErrMaxPayload) {
+		return fmt.Errorf("%w: %w", ErrValueTooLarge, err)
+	}
+
+	return err
+}
+
 // NewNATSKeyValue returns a new instance of a natsKeyValue.
 func NewNATSKeyValue(bucket jetstream.KeyValue, logger *slog.Logger) Store {
 	return &natsKeyValue{
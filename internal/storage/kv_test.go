@@ -0,0 +1,503 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	natsserver "github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// newStores returns a constructor for each store implementation so the same cases run against all of
+// them. The NATS store uses a new bucket, keeping 5 revisions of each key, on an embedded JetStream server
+// started once for the test.
+func newStores(t *testing.T) map[string]func(t *testing.T) Store {
+	t.Helper()
+
+	ns, err := natsserver.NewServer(&natsserver.Options{Port: -1, JetStream: true, StoreDir: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go ns.Start()
+	if !ns.ReadyForConnections(5 * time.Second) {
+		t.Fatal("nats server is not ready")
+	}
+	t.Cleanup(ns.Shutdown)
+
+	nc, err := nats.Connect(ns.ClientURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(nc.Close)
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buckets int
+
+	return map[string]func(t *testing.T) Store{
+		"inMemory": func(t *testing.T) Store {
+			return NewInMemory()
+		},
+		"natsKeyValue": func(t *testing.T) Store {
+			buckets++
+
+			kv, err := js.CreateKeyValue(context.TODO(), jetstream.KeyValueConfig{Bucket: fmt.Sprintf("test-%d", buckets), History: 5})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			return NewNATSKeyValue(kv, slog.New(slog.NewTextHandler(io.Discard, nil)))
+		},
+	}
+}
+
+// seed stores the items in the store.
+func seed(t *testing.T, s Store, items map[string]Item) {
+	t.Helper()
+
+	for k, i := range items {
+		if _, err := s.Set(context.TODO(), k, i); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestStore_Increment(t *testing.T) {
+	type args struct {
+		key   string
+		delta int64
+		ttl   int64
+	}
+	tests := []struct {
+		name    string
+		items   map[string]Item
+		args    args
+		want    int64
+		wantTTL int64
+		wantErr error
+	}{
+		{
+			name:    "should create the key if it does not exist",
+			args:    args{key: "test", delta: 5, ttl: 100},
+			want:    5,
+			wantTTL: 100,
+		},
+		{
+			name:    "should add the delta and keep the existing ttl",
+			items:   map[string]Item{"test": {Value: []byte("10")}},
+			args:    args{key: "test", delta: -3, ttl: 100},
+			want:    7,
+			wantTTL: 0,
+		},
+		{
+			name:  "should reset an expired key",
+			items: map[string]Item{"test": {Value: []byte("10"), TTL: time.Now().Unix() - 20}},
+			args:  args{key: "test", delta: 1},
+			want:  1,
+		},
+		{
+			name:    "should error if the value is not an integer",
+			items:   map[string]Item{"test": {Value: []byte("test")}},
+			args:    args{key: "test", delta: 1},
+			wantErr: ErrNotInteger,
+		},
+		{
+			name:    "should error if the value would overflow",
+			items:   map[string]Item{"test": {Value: []byte("9223372036854775807")}},
+			args:    args{key: "test", delta: 1},
+			wantErr: ErrOverflow,
+		},
+	}
+	for store, newStore := range newStores(t) {
+		for _, tt := range tests {
+			t.Run(store+"/"+tt.name, func(t *testing.T) {
+				s := newStore(t)
+				seed(t, s, tt.items)
+
+				got, gotTTL, err := s.Increment(context.TODO(), tt.args.key, tt.args.delta, tt.args.ttl)
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("%s.Increment() error = %v, wantErr %v", store, err, tt.wantErr)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("%s.Increment() got = %v, want %v", store, got, tt.want)
+				}
+				if gotTTL != tt.wantTTL {
+					t.Errorf("%s.Increment() gotTTL = %v, want %v", store, gotTTL, tt.wantTTL)
+				}
+			})
+		}
+
+		t.Run(store+"/should not lose concurrent increments", func(t *testing.T) {
+			s := newStore(t)
+
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+
+					for j := 0; j < 10; j++ {
+						if _, _, err := s.Increment(context.TODO(), "test", 1, 0); err != nil {
+							t.Error(err)
+						}
+					}
+				}()
+			}
+			wg.Wait()
+
+			got, _, err := s.Increment(context.TODO(), "test", 0, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != 100 {
+				t.Errorf("%s.Increment() got = %v, want %v", store, got, 100)
+			}
+		})
+	}
+}
+
+func TestStore_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		items   map[string]Item
+		key     string
+		wantErr error
+	}{
+		{
+			name: "should create the key if it does not exist",
+			key:  "test",
+		},
+		{
+			name:  "should replace an expired key",
+			items: map[string]Item{"test": {Value: []byte("test"), TTL: time.Now().Unix() - 20}},
+			key:   "test",
+		},
+		{
+			name:    "should error if the key exists",
+			items:   map[string]Item{"test": {Value: []byte("test")}},
+			key:     "test",
+			wantErr: ErrKeyExists,
+		},
+	}
+	for store, newStore := range newStores(t) {
+		for _, tt := range tests {
+			t.Run(store+"/"+tt.name, func(t *testing.T) {
+				s := newStore(t)
+				seed(t, s, tt.items)
+
+				revision, err := s.Create(context.TODO(), tt.key, Item{Value: []byte("new")})
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("%s.Create() error = %v, wantErr %v", store, err, tt.wantErr)
+					return
+				}
+
+				e, err := s.Get(context.TODO(), tt.key)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				want := "new"
+				if tt.wantErr != nil {
+					want = string(tt.items[tt.key].Value)
+				}
+				if e == nil || string(e.Value) != want {
+					t.Fatalf("%s.Get() = %v, want %v", store, e, want)
+				}
+				if tt.wantErr == nil && e.Revision != revision {
+					t.Errorf("%s.Create() revision = %v, want %v", store, revision, e.Revision)
+				}
+			})
+		}
+	}
+}
+
+func TestStore_Update(t *testing.T) {
+	for store, newStore := range newStores(t) {
+		t.Run(store, func(t *testing.T) {
+			s := newStore(t)
+
+			revision, err := s.Update(context.TODO(), "test", Item{Value: []byte("first")}, 0)
+			if err != nil {
+				t.Fatalf("%s.Update() of a missing key error = %v, wantErr %v", store, err, nil)
+			}
+
+			if _, err := s.Update(context.TODO(), "test", Item{Value: []byte("stale")}, 0); !errors.Is(err, ErrRevisionMismatch) {
+				t.Errorf("%s.Update() of an existing key at 0 error = %v, wantErr %v", store, err, ErrRevisionMismatch)
+			}
+
+			next, err := s.Update(context.TODO(), "test", Item{Value: []byte("second")}, revision)
+			if err != nil {
+				t.Errorf("%s.Update() error = %v, wantErr %v", store, err, nil)
+			}
+			if next <= revision {
+				t.Errorf("%s.Update() revision = %v, want greater than %v", store, next, revision)
+			}
+
+			if _, err := s.Update(context.TODO(), "test", Item{Value: []byte("third")}, revision); !errors.Is(err, ErrRevisionMismatch) {
+				t.Errorf("%s.Update() error = %v, wantErr %v", store, err, ErrRevisionMismatch)
+			}
+
+			e, err := s.Get(context.TODO(), "test")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(e.Value) != "second" || e.Revision != next {
+				t.Errorf("%s.Get() = %v at %v, want %v at %v", store, string(e.Value), e.Revision, "second", next)
+			}
+		})
+	}
+}
+
+func Test_natsKeyValue_invalidKey(t *testing.T) {
+	// the bucket only accepts -/_=.a-zA-Z0-9 in keys, the in-memory store accepts any key
+	s := newStores(t)["natsKeyValue"](t)
+
+	if _, err := s.Set(context.TODO(), "test.0-user:42", Item{Value: []byte("test")}); err == nil {
+		t.Errorf("natsKeyValue.Set() error = %v, want an error", err)
+	}
+
+	if _, err := s.Create(context.TODO(), "test.0-user:42", Item{Value: []byte("test")}); err == nil {
+		t.Errorf("natsKeyValue.Create() error = %v, want an error", err)
+	}
+}
+
+func TestStore_Watch(t *testing.T) {
+	tests := []struct {
+		name   string
+		key    string
+		prefix bool
+		want   []string
+	}{
+		{
+			name:   "should send the changes of keys starting with the prefix",
+			key:    "test.0-a",
+			prefix: true,
+			want:   []string{"test.0-a", "test.0-ab", "test.0-ab"},
+		},
+		{
+			name: "should send the changes of the key",
+			key:  "test.0-a",
+			want: []string{"test.0-a"},
+		},
+	}
+	for store, newStore := range newStores(t) {
+		for _, tt := range tests {
+			t.Run(store+"/"+tt.name, func(t *testing.T) {
+				ctx, cancel := context.WithCancel(context.TODO())
+				defer cancel()
+
+				s := newStore(t)
+
+				events, err := s.Watch(ctx, tt.key, tt.prefix)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				for _, k := range []string{"test.0-b", "test.0-a", "test.1-a", "test.0.lock-a", "test.0-ab"} {
+					if _, err := s.Set(ctx, k, Item{Value: []byte("test")}); err != nil {
+						t.Fatal(err)
+					}
+				}
+				if err := s.Delete(ctx, "test.0-ab"); err != nil {
+					t.Fatal(err)
+				}
+
+				ops := []Operation{OperationPut, OperationPut, OperationDelete}
+				for i, key := range tt.want {
+					select {
+					case e := <-events:
+						if e.Key != key || e.Operation != ops[i] {
+							t.Errorf("%s.Watch() got = %v %v, want %v %v", store, e.Key, e.Operation, key, ops[i])
+						}
+					case <-time.After(time.Second):
+						t.Fatalf("%s.Watch() timed out waiting for %v", store, key)
+					}
+				}
+
+				select {
+				case e := <-events:
+					t.Errorf("%s.Watch() got = %v %v, want no more events", store, e.Key, e.Operation)
+				case <-time.After(50 * time.Millisecond):
+				}
+			})
+		}
+	}
+}
+
+func TestStore_Keys(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix string
+		want   []string
+	}{
+		{
+			name:   "should return the keys of the database",
+			prefix: "test.0-",
+			want:   []string{"test.0-a", "test.0-a.b", "test.0-b"},
+		},
+		{
+			name:   "should return the keys starting with a partial token",
+			prefix: "test.0-a",
+			want:   []string{"test.0-a", "test.0-a.b"},
+		},
+		{
+			name:   "should return the keys starting with complete tokens",
+			prefix: "test.0-a.",
+			want:   []string{"test.0-a.b"},
+		},
+		{
+			name:   "should return no keys",
+			prefix: "missing.0-",
+		},
+	}
+	for store, newStore := range newStores(t) {
+		for _, tt := range tests {
+			t.Run(store+"/"+tt.name, func(t *testing.T) {
+				s := newStore(t)
+				seed(t, s, map[string]Item{
+					"test.0-b":      {Value: []byte("test")},
+					"test.0-a":      {Value: []byte("test")},
+					"test.0-a.b":    {Value: []byte("test")},
+					"test.0.lock-a": {Value: []byte("test")},
+					"test.1-a":      {Value: []byte("test")},
+					"other.0-a":     {Value: []byte("test")},
+				})
+
+				// deleted keys are not returned
+				if _, err := s.Set(context.TODO(), "test.0-c", Item{Value: []byte("test")}); err != nil {
+					t.Fatal(err)
+				}
+				if err := s.Delete(context.TODO(), "test.0-c"); err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := s.Keys(context.TODO(), tt.prefix)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("%s.Keys() = %v, want %v", store, got, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestStore_History(t *testing.T) {
+	// the in-memory store only keeps the latest revision of a key
+	kept := map[string]int{"inMemory": 1, "natsKeyValue": 3}
+
+	for store, newStore := range newStores(t) {
+		t.Run(store, func(t *testing.T) {
+			s := newStore(t)
+
+			var revisions []uint64
+			for _, v := range []string{"first", "second", "third"} {
+				revision, err := s.Set(context.TODO(), "test", Item{Value: []byte(v)})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				revisions = append(revisions, revision)
+			}
+
+			events, err := s.History(context.TODO(), "test")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(events) != kept[store] {
+				t.Fatalf("%s.History() = %d events, want %d", store, len(events), kept[store])
+			}
+
+			values := []string{"first", "second", "third"}[3-kept[store]:]
+			for i, e := range events {
+				if string(e.Value) != values[i] || e.Revision != revisions[3-kept[store]+i] || e.Operation != OperationPut {
+					t.Errorf("%s.History() event %d = %v at %v, want %v at %v", store, i, string(e.Value), e.Revision, values[i], revisions[3-kept[store]+i])
+				}
+			}
+
+			e, err := s.GetRevision(context.TODO(), "test", revisions[2])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if e == nil || string(e.Value) != "third" {
+				t.Errorf("%s.GetRevision() = %v, want %v", store, e, "third")
+			}
+
+			missing, err := s.History(context.TODO(), "missing")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(missing) != 0 {
+				t.Errorf("%s.History() of a missing key = %v, want no events", store, missing)
+			}
+		})
+	}
+}
+
+func TestStore_Purge(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix string
+		want   []string
+	}{
+		{
+			name:   "should delete the keys of the database",
+			prefix: "test.0-",
+			want:   []string{"other.0-a", "test.0.lock-a", "test.1-a"},
+		},
+		{
+			name:   "should delete the keys starting with a partial token",
+			prefix: "test.0-a",
+			want:   []string{"other.0-a", "test.0-b", "test.0.lock-a", "test.1-a"},
+		},
+		{
+			name:   "should delete the key",
+			prefix: "test.0-a.b",
+			want:   []string{"other.0-a", "test.0-a", "test.0-b", "test.0.lock-a", "test.1-a"},
+		},
+	}
+	for store, newStore := range newStores(t) {
+		for _, tt := range tests {
+			t.Run(store+"/"+tt.name, func(t *testing.T) {
+				s := newStore(t)
+				seed(t, s, map[string]Item{
+					"test.0-b":      {Value: []byte("test")},
+					"test.0-a":      {Value: []byte("test")},
+					"test.0-a.b":    {Value: []byte("test")},
+					"test.0.lock-a": {Value: []byte("test")},
+					"test.1-a":      {Value: []byte("test")},
+					"other.0-a":     {Value: []byte("test")},
+				})
+
+				if err := s.Purge(context.TODO(), tt.prefix); err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := s.Keys(context.TODO(), "")
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("%s.Keys() after Purge() = %v, want %v", store, got, tt.want)
+				}
+			})
+		}
+	}
+}
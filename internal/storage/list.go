@@ -0,0 +1,245 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// List is an ordered list of values stored as a single key. The values share the ttl of the key and
+// every change is written using the revision the list was read at so concurrent writers never lose a
+// value.
+type List [][]byte
+
+// list decodes the list stored in the entry, a nil entry is an empty list.
+func list(e *Entry) (List, error) {
+	var l List
+	if e == nil || len(e.Value) == 0 {
+		return l, nil
+	}
+
+	if err := json.Unmarshal(e.Value, &l); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// item encodes the list into an item with the ttl, an empty list is stored as an expired item so it is
+// removed from the store.
+func (l List) item(ttl int64) (Item, error) {
+	if len(l) == 0 {
+		return Item{TTL: time.Now().Add(-time.Second).Unix()}, nil
+	}
+
+	b, err := json.Marshal(l)
+	if err != nil {
+		return Item{}, err
+	}
+
+	return Item{Value: b, TTL: ttl}, nil
+}
+
+// bounds converts the inclusive start and stop indexes, where negative indexes count from the end of
+// the list, into a slice range of a list with n values. If the range is empty start equals stop.
+func bounds(n int, start, stop int64) (int, int) {
+	if start < 0 {
+		start += int64(n)
+	}
+
+	if stop < 0 {
+		stop += int64(n)
+	}
+
+	start = max(start, 0)
+	stop = min(stop, int64(n)-1)
+
+	if start > stop {
+		return 0, 0
+	}
+
+	return int(start), int(stop) + 1
+}
+
+// ListGet returns the values in the list and the ttl of the list. If the list does not exist the list is
+// empty.
+func ListGet(ctx context.Context, s Store, key string) (List, int64, error) {
+	e, err := s.Get(ctx, key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	l, err := list(e)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if e == nil {
+		return l, 0, nil
+	}
+
+	return l, e.TTL, nil
+}
+
+// ListRange returns the values between the inclusive start and stop indexes, negative indexes count from
+// the end of the list.
+func ListRange(ctx context.Context, s Store, key string, start, stop int64) (List, error) {
+	l, _, err := ListGet(ctx, s, key)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := bounds(len(l), start, stop)
+
+	return l[from:to], nil
+}
+
+// ListPush adds the values to the front of the list when left is true, so the last value is the first
+// in the list, or to the back of the list, and returns the length of the list. If the ttl is not 0 it
+// replaces the ttl of the list, otherwise the list keeps its ttl.
+func ListPush(ctx context.Context, s Store, key string, values [][]byte, left bool, ttl int64) (int, error) {
+	var length int
+
+	_, err := Mutate(ctx, s, key, func(e *Entry) (Item, error) {
+		l, err := list(e)
+		if err != nil {
+			return Item{}, err
+		}
+
+		if left {
+			pushed := make(List, 0, len(values)+len(l))
+			for i := len(values) - 1; i >= 0; i-- {
+				pushed = append(pushed, values[i])
+			}
+
+			l = append(pushed, l...)
+		} else {
+			l = append(l, values...)
+		}
+
+		length = len(l)
+
+		expires := ttl
+		if expires == 0 && e != nil {
+			expires = e.TTL
+		}
+
+		return l.item(expires)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return length, nil
+}
+
+// ListPop removes up to count values from the front of the list when left is true, or from the back of
+// the list, and returns them in the order they were removed.
+func ListPop(ctx context.Context, s Store, key string, count int, left bool) (List, error) {
+	var popped List
+
+	_, err := Mutate(ctx, s, key, func(e *Entry) (Item, error) {
+		popped = nil
+
+		l, err := list(e)
+		if err != nil {
+			return Item{}, err
+		}
+
+		if len(l) == 0 {
+			return Item{}, errNoChange
+		}
+
+		n := min(count, len(l))
+		if left {
+			popped = append(popped, l[:n]...)
+			l = l[n:]
+		} else {
+			for i := len(l) - 1; i >= len(l)-n; i-- {
+				popped = append(popped, l[i])
+			}
+
+			l = l[:len(l)-n]
+		}
+
+		return l.item(e.TTL)
+	})
+	if err != nil && !errors.Is(err, errNoChange) {
+		return nil, err
+	}
+
+	return popped, nil
+}
+
+// ListPopWait pops values like ListPop, if the list is empty it waits for values to be pushed until the
+// context is done. If the context is done before a value is popped the list is empty and no error is
+// returned.
+func ListPopWait(ctx context.Context, s Store, key string, count int, left bool) (List, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// watch before the first pop so a push between the pop and the watch is not missed
+	events, err := s.Watch(ctx, key, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		popped, err := ListPop(ctx, s, key, count, left)
+
+		// the values are already removed from the list so they are returned even if the context is done
+		if err == nil && len(popped) > 0 {
+			return popped, nil
+		}
+
+		if ctx.Err() != nil {
+			return nil, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		// wait for the next change, another client may pop the values first so the pop is retried
+		for {
+			e, ok := <-events
+			if !ok {
+				return nil, nil
+			}
+
+			if e.Operation == OperationPut {
+				break
+			}
+		}
+	}
+}
+
+// ListTrim removes the values outside of the inclusive start and stop indexes, negative indexes count
+// from the end of the list, and returns the length of the list.
+func ListTrim(ctx context.Context, s Store, key string, start, stop int64) (int, error) {
+	var length int
+
+	_, err := Mutate(ctx, s, key, func(e *Entry) (Item, error) {
+		l, err := list(e)
+		if err != nil {
+			return Item{}, err
+		}
+
+		if len(l) == 0 {
+			length = 0
+			return Item{}, errNoChange
+		}
+
+		from, to := bounds(len(l), start, stop)
+		l = l[from:to]
+		length = len(l)
+
+		return l.item(e.TTL)
+	})
+	if err != nil && !errors.Is(err, errNoChange) {
+		return 0, err
+	}
+
+	return length, nil
+}
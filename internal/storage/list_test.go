@@ -0,0 +1,199 @@
+package storage
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func listValues(l List) []string {
+	s := make([]string, len(l))
+	for i, v := range l {
+		s[i] = string(v)
+	}
+
+	return s
+}
+
+func TestListPush(t *testing.T) {
+	s := NewInMemory()
+
+	if _, err := ListPush(context.TODO(), s, "test", [][]byte{[]byte("b"), []byte("c")}, false, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	length, err := ListPush(context.TODO(), s, "test", [][]byte{[]byte("z"), []byte("a")}, true, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if length != 4 {
+		t.Errorf("ListPush() = %v, want %v", length, 4)
+	}
+
+	l, _, err := ListGet(context.TODO(), s, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a", "z", "b", "c"}; !reflect.DeepEqual(listValues(l), want) {
+		t.Errorf("ListGet() = %v, want %v", listValues(l), want)
+	}
+}
+
+func TestListPop(t *testing.T) {
+	s := NewInMemory()
+
+	if _, err := ListPush(context.TODO(), s, "test", [][]byte{[]byte("a"), []byte("b"), []byte("c")}, false, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	l, err := ListPop(context.TODO(), s, "test", 1, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a"}; !reflect.DeepEqual(listValues(l), want) {
+		t.Errorf("ListPop() = %v, want %v", listValues(l), want)
+	}
+
+	l, err = ListPop(context.TODO(), s, "test", 5, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"c", "b"}; !reflect.DeepEqual(listValues(l), want) {
+		t.Errorf("ListPop() = %v, want %v", listValues(l), want)
+	}
+
+	l, err = ListPop(context.TODO(), s, "test", 1, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(l) != 0 {
+		t.Errorf("ListPop() = %v, want an empty list", listValues(l))
+	}
+
+	if e, _ := s.Get(context.TODO(), "test"); e != nil {
+		t.Errorf("Get() = %v, want nil after the last value was popped", e)
+	}
+}
+
+func TestListPopWait(t *testing.T) {
+	s := NewInMemory()
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+
+		if _, err := ListPush(context.TODO(), s, "test", [][]byte{[]byte("a")}, false, 0); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second)
+	defer cancel()
+
+	l, err := ListPopWait(ctx, s, "test", 1, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a"}; !reflect.DeepEqual(listValues(l), want) {
+		t.Errorf("ListPopWait() = %v, want %v", listValues(l), want)
+	}
+
+	ctx, cancel = context.WithTimeout(context.TODO(), 50*time.Millisecond)
+	defer cancel()
+
+	l, err = ListPopWait(ctx, s, "test", 1, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(l) != 0 {
+		t.Errorf("ListPopWait() = %v, want an empty list", listValues(l))
+	}
+}
+
+// cancelStore cancels the context once a value was written, like a deadline that passes during the write.
+type cancelStore struct {
+	Store
+	cancel context.CancelFunc
+}
+
+func (c cancelStore) Update(ctx context.Context, key string, i Item, revision uint64) (uint64, error) {
+	defer c.cancel()
+
+	return c.Store.Update(ctx, key, i, revision)
+}
+
+func TestListPopWait_done(t *testing.T) {
+	s := NewInMemory()
+
+	if _, err := ListPush(context.TODO(), s, "test", [][]byte{[]byte("a"), []byte("b")}, false, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	// the value is removed from the list when the context is done so it is still returned
+	l, err := ListPopWait(ctx, cancelStore{Store: s, cancel: cancel}, "test", 1, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a"}; !reflect.DeepEqual(listValues(l), want) {
+		t.Errorf("ListPopWait() = %v, want %v", listValues(l), want)
+	}
+}
+
+func TestListRange(t *testing.T) {
+	s := NewInMemory()
+
+	if _, err := ListPush(context.TODO(), s, "test", [][]byte{[]byte("a"), []byte("b"), []byte("c"), []byte("d")}, false, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		start int64
+		stop  int64
+		want  []string
+	}{
+		{name: "every value", start: 0, stop: -1, want: []string{"a", "b", "c", "d"}},
+		{name: "first two values", start: 0, stop: 1, want: []string{"a", "b"}},
+		{name: "last two values", start: -2, stop: -1, want: []string{"c", "d"}},
+		{name: "stop past the end", start: 2, stop: 100, want: []string{"c", "d"}},
+		{name: "start past the stop", start: 3, stop: 1, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := ListRange(context.TODO(), s, "test", tt.start, tt.stop)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(listValues(l), tt.want) {
+				t.Errorf("ListRange() = %v, want %v", listValues(l), tt.want)
+			}
+		})
+	}
+}
+
+func TestListTrim(t *testing.T) {
+	s := NewInMemory()
+
+	if _, err := ListPush(context.TODO(), s, "test", [][]byte{[]byte("a"), []byte("b"), []byte("c"), []byte("d")}, false, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	length, err := ListTrim(context.TODO(), s, "test", 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if length != 2 {
+		t.Errorf("ListTrim() = %v, want %v", length, 2)
+	}
+
+	l, _, err := ListGet(context.TODO(), s, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a", "b"}; !reflect.DeepEqual(listValues(l), want) {
+		t.Errorf("ListGet() = %v, want %v", listValues(l), want)
+	}
+}
@@ -0,0 +1,246 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jasonmccallister/nats-cache/internal/keygen"
+	"github.com/nats-io/nats.go"
+)
+
+// ObjectInfo describes an object in an ObjectStore.
+type ObjectInfo struct {
+	Name     string
+	Size     uint64
+	Modified time.Time
+}
+
+// ObjectStore stores values that are too large to store in an Item. Objects do not expire, the item that
+// links to the object is used for the ttl and the object is removed along with the item.
+type ObjectStore interface {
+	// Put stores the object read from r and returns the size of the object.
+	Put(ctx context.Context, name string, r io.Reader) (uint64, error)
+	// Get returns a reader for the object, if the object does not exist ErrKeyNotFound is returned.
+	Get(ctx context.Context, name string) (io.ReadCloser, error)
+	// Delete removes the object, removing an object that does not exist is not an error.
+	Delete(ctx context.Context, name string) error
+	// List returns the objects with a name starting with the prefix sorted by name. When the prefix
+	// belongs to a subject only objects of the subject are returned.
+	List(ctx context.Context, prefix string) ([]ObjectInfo, error)
+}
+
+type natsObjectStore struct {
+	store  nats.ObjectStore
+	logger *slog.Logger
+}
+
+func (n *natsObjectStore) Put(ctx context.Context, name string, r io.Reader) (uint64, error) {
+	info, err := n.store.Put(&nats.ObjectMeta{Name: name}, r, nats.Context(ctx))
+	if err != nil {
+		n.logger.ErrorContext(ctx, "failed to put object", "name", name, "error", err.Error())
+
+		return 0, err
+	}
+
+	n.logger.InfoContext(ctx, "put object", "name", name, "size", info.Size, "chunks", info.Chunks)
+
+	return info.Size, nil
+}
+
+func (n *natsObjectStore) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	r, err := n.store.Get(name, nats.Context(ctx))
+	if err != nil {
+		if errors.Is(err, nats.ErrObjectNotFound) {
+			return nil, ErrKeyNotFound
+		}
+
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (n *natsObjectStore) Delete(ctx context.Context, name string) error {
+	if err := n.store.Delete(name); err != nil && !errors.Is(err, nats.ErrObjectNotFound) {
+		n.logger.ErrorContext(ctx, "failed to delete object", "name", name, "error", err.Error())
+
+		return err
+	}
+
+	n.logger.InfoContext(ctx, "deleted object", "name", name)
+
+	return nil
+}
+
+func (n *natsObjectStore) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	infos, err := n.store.List(nats.Context(ctx))
+	if err != nil {
+		if errors.Is(err, nats.ErrNoObjectsFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var objects []ObjectInfo
+	for _, info := range infos {
+		if info.Deleted || !matchObject(info.Name, prefix) {
+			continue
+		}
+
+		objects = append(objects, ObjectInfo{
+			Name:     info.Name,
+			Size:     info.Size,
+			Modified: info.ModTime,
+		})
+	}
+
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Name < objects[j].Name })
+
+	return objects, nil
+}
+
+// NewNATSObjectStore returns a new ObjectStore using the JetStream object store.
+func NewNATSObjectStore(store nats.ObjectStore, logger *slog.Logger) ObjectStore {
+	return &natsObjectStore{
+		store:  store,
+		logger: logger,
+	}
+}
+
+type inMemoryObject struct {
+	data     []byte
+	modified time.Time
+}
+
+type inMemoryObjects struct {
+	mu      sync.RWMutex
+	objects map[string]inMemoryObject
+}
+
+func (i *inMemoryObjects) Put(ctx context.Context, name string, r io.Reader) (uint64, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.objects[name] = inMemoryObject{data: b, modified: time.Now()}
+
+	return uint64(len(b)), nil
+}
+
+func (i *inMemoryObjects) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	o, ok := i.objects[name]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+
+	return io.NopCloser(bytes.NewReader(o.data)), nil
+}
+
+func (i *inMemoryObjects) Delete(ctx context.Context, name string) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	delete(i.objects, name)
+
+	return nil
+}
+
+func (i *inMemoryObjects) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	var objects []ObjectInfo
+	for name, o := range i.objects {
+		if matchObject(name, prefix) {
+			objects = append(objects, ObjectInfo{Name: name, Size: uint64(len(o.data)), Modified: o.modified})
+		}
+	}
+
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Name < objects[j].Name })
+
+	return objects, nil
+}
+
+// NewInMemoryObjects returns a new ObjectStore that keeps objects in memory.
+func NewInMemoryObjects() ObjectStore {
+	return &inMemoryObjects{
+		objects: make(map[string]inMemoryObject),
+	}
+}
+
+// matchObject reports whether the object name starts with the prefix and belongs to the same subject as
+// the prefix, the object store lists the objects of every subject so objects are scoped before they are
+// returned.
+func matchObject(name, prefix string) bool {
+	if !strings.HasPrefix(name, prefix) {
+		return false
+	}
+
+	subject, ok := keygen.Subject(prefix)
+	if !ok {
+		return true
+	}
+
+	s, _ := keygen.Subject(name)
+
+	return s == subject
+}
+
+// ObjectKey returns the key of the item that links to the object, objects are named after the key so
+// they can be removed by prefix along with the keys.
+func ObjectKey(name string) string {
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		return name[:i]
+	}
+
+	return name
+}
+
+// SweepObjects removes objects that are no longer linked from their key, such as objects of keys that
+// expired. Objects modified within the grace period are kept since the key is written after the object
+// is uploaded. The number of objects removed is returned.
+func SweepObjects(ctx context.Context, s Store, o ObjectStore, grace time.Duration) (int, error) {
+	objects, err := o.List(ctx, "")
+	if err != nil {
+		return 0, err
+	}
+
+	var n int
+	for _, obj := range objects {
+		if time.Since(obj.Modified) < grace {
+			continue
+		}
+
+		e, err := s.Get(ctx, ObjectKey(obj.Name))
+		if err != nil {
+			return n, err
+		}
+
+		if e != nil && e.Object == obj.Name {
+			continue
+		}
+
+		if err := o.Delete(ctx, obj.Name); err != nil {
+			return n, err
+		}
+
+		n++
+	}
+
+	return n, nil
+}
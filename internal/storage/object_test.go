@@ -0,0 +1,91 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestObjectKey(t *testing.T) {
+	if got := ObjectKey("sub.0-a/b/0123abcd"); got != "sub.0-a/b" {
+		t.Errorf("ObjectKey() = %v, want sub.0-a/b", got)
+	}
+}
+
+func TestSweepObjects(t *testing.T) {
+	s := NewInMemory()
+	o := NewInMemoryObjects()
+
+	for _, name := range []string{"sub.0-linked/1", "sub.0-linked/2", "sub.0-expired/1"} {
+		if _, err := o.Put(context.TODO(), name, strings.NewReader(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := s.Set(context.TODO(), "sub.0-linked", Item{Object: "sub.0-linked/2"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Set(context.TODO(), "sub.0-expired", Item{Object: "sub.0-expired/1", TTL: time.Now().Unix() - 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	// objects within the grace period are kept
+	n, err := SweepObjects(context.TODO(), s, o, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 0 {
+		t.Errorf("SweepObjects() = %v, want 0 within the grace period", n)
+	}
+
+	n, err = SweepObjects(context.TODO(), s, o, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("SweepObjects() = %v, want 2", n)
+	}
+
+	objects, err := o.List(context.TODO(), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objects) != 1 || objects[0].Name != "sub.0-linked/2" {
+		t.Errorf("List() = %v, want only the linked object", objects)
+	}
+
+	r, err := o.Get(context.TODO(), "sub.0-linked/2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	b, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "sub.0-linked/2" {
+		t.Errorf("Get() = %s, want sub.0-linked/2", b)
+	}
+}
+
+func TestObjectStore_List(t *testing.T) {
+	o := NewInMemoryObjects()
+
+	for _, name := range []string{"alice.0-a/1", "alice.0-b/1", "alice.1-a/1", "bob.0-a/1"} {
+		if _, err := o.Put(context.TODO(), name, strings.NewReader(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	objects, err := o.List(context.TODO(), "alice.0-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(objects) != 2 || objects[0].Name != "alice.0-a/1" || objects[1].Name != "alice.0-b/1" {
+		t.Errorf("List() = %v, want the objects of alice in database 0", objects)
+	}
+}
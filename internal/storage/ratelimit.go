@@ -0,0 +1,197 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"time"
+)
+
+// ErrCostExceedsLimit is returned when a rate limit is checked with a cost that can never be allowed.
+var ErrCostExceedsLimit = errors.New("cost is greater than the limit")
+
+// Algorithm is the algorithm used to enforce a rate limit.
+type Algorithm int
+
+const (
+	// AlgorithmTokenBucket allows bursts up to the limit and refills the bucket evenly over the window.
+	AlgorithmTokenBucket Algorithm = iota
+	// AlgorithmSlidingWindow allows up to the limit in any window, it is approximated by weighting the
+	// count of the previous window by how much of it overlaps the sliding window.
+	AlgorithmSlidingWindow
+)
+
+// Limit is the number of tokens allowed in the window.
+type Limit struct {
+	Algorithm Algorithm
+	Limit     int64
+	Window    time.Duration
+}
+
+// RateLimitResult is the result of checking a rate limit. If the request was not allowed RetryAfter is
+// how long until the cost would be allowed.
+type RateLimitResult struct {
+	Allowed    bool
+	Remaining  int64
+	RetryAfter time.Duration
+}
+
+// tokenBucket is the state of a token bucket, updated is the time the tokens were counted in unix
+// nanoseconds.
+type tokenBucket struct {
+	Tokens  float64 `json:"tokens"`
+	Updated int64   `json:"updated"`
+}
+
+// slidingWindow is the state of a sliding window, start is the start of the current window in unix
+// nanoseconds.
+type slidingWindow struct {
+	Start    int64 `json:"start"`
+	Current  int64 `json:"current"`
+	Previous int64 `json:"previous"`
+}
+
+// RateLimit consumes the cost from the rate limit stored at the key if it is allowed at the time now.
+// The state of the limit is written using the revision it was read at so the limit is enforced across
+// every client sharing the store.
+func RateLimit(ctx context.Context, s Store, key string, l Limit, cost int64, now time.Time) (RateLimitResult, error) {
+	if cost > l.Limit {
+		return RateLimitResult{}, ErrCostExceedsLimit
+	}
+
+	var result RateLimitResult
+
+	_, err := Mutate(ctx, s, key, func(e *Entry) (Item, error) {
+		var value any
+		var ttl time.Time
+		var err error
+
+		switch l.Algorithm {
+		case AlgorithmSlidingWindow:
+			var w slidingWindow
+			if e != nil {
+				if err := json.Unmarshal(e.Value, &w); err != nil {
+					return Item{}, err
+				}
+			}
+
+			result = w.take(l, cost, now)
+			value = w
+			ttl = time.Unix(0, w.Start).Add(2 * l.Window)
+		default:
+			var b tokenBucket
+			if e == nil {
+				b.Tokens = float64(l.Limit)
+				b.Updated = now.UnixNano()
+			} else if err := json.Unmarshal(e.Value, &b); err != nil {
+				return Item{}, err
+			}
+
+			result = b.take(l, cost, now)
+			value = b
+			// the bucket is full again after the window and the state is no longer needed
+			ttl = now.Add(l.Window)
+		}
+
+		if !result.Allowed {
+			return Item{}, errNoChange
+		}
+
+		b, err := json.Marshal(value)
+		if err != nil {
+			return Item{}, err
+		}
+
+		// the ttl is rounded up so the state is never removed early
+		return Item{Value: b, TTL: ttl.Unix() + 1}, nil
+	})
+	if err != nil && !errors.Is(err, errNoChange) {
+		return RateLimitResult{}, err
+	}
+
+	return result, nil
+}
+
+// take refills the bucket for the time since it was last updated and takes the cost if there are
+// enough tokens.
+func (b *tokenBucket) take(l Limit, cost int64, now time.Time) RateLimitResult {
+	// tokens per nanosecond
+	rate := float64(l.Limit) / float64(l.Window)
+
+	elapsed := max(now.UnixNano()-b.Updated, 0)
+	b.Tokens = min(float64(l.Limit), b.Tokens+float64(elapsed)*rate)
+	b.Updated = now.UnixNano()
+
+	if b.Tokens < float64(cost) {
+		return RateLimitResult{
+			Remaining:  int64(b.Tokens),
+			RetryAfter: time.Duration(math.Ceil((float64(cost) - b.Tokens) / rate)),
+		}
+	}
+
+	b.Tokens -= float64(cost)
+
+	return RateLimitResult{
+		Allowed:   true,
+		Remaining: int64(b.Tokens),
+	}
+}
+
+// take moves the window forward to the time now and takes the cost if the weighted count of the
+// previous and current windows allows it.
+func (w *slidingWindow) take(l Limit, cost int64, now time.Time) RateLimitResult {
+	window := int64(l.Window)
+	start := now.UnixNano() - now.UnixNano()%window
+
+	switch {
+	case w.Start == start:
+	case w.Start == start-window:
+		w.Previous, w.Current = w.Current, 0
+	default:
+		w.Previous, w.Current = 0, 0
+	}
+
+	w.Start = start
+
+	elapsed := float64(now.UnixNano()-start) / float64(window)
+	count := float64(w.Previous)*(1-elapsed) + float64(w.Current)
+
+	if count+float64(cost) > float64(l.Limit) {
+		return RateLimitResult{
+			Remaining:  max(l.Limit-int64(math.Ceil(count)), 0),
+			RetryAfter: w.retryAfter(l, cost, now),
+		}
+	}
+
+	w.Current += cost
+
+	return RateLimitResult{
+		Allowed:   true,
+		Remaining: max(l.Limit-int64(math.Ceil(count))-cost, 0),
+	}
+}
+
+// retryAfter returns how long until the weighted count allows the cost, either later in the current
+// window as the previous window is weighted less or in the next window.
+func (w *slidingWindow) retryAfter(l Limit, cost int64, now time.Time) time.Duration {
+	window := float64(l.Window)
+	elapsed := float64(now.UnixNano() - w.Start)
+	remaining := window - elapsed
+
+	// the previous window is weighted less as the window slides
+	if w.Previous > 0 && w.Current+cost <= l.Limit {
+		wait := window*(1-float64(l.Limit-w.Current-cost)/float64(w.Previous)) - elapsed
+		if wait < remaining {
+			return time.Duration(math.Ceil(max(wait, 0)))
+		}
+	}
+
+	// in the next window the current window becomes the previous window
+	var wait float64
+	if w.Current > 0 {
+		wait = max(window*(1-float64(l.Limit-cost)/float64(w.Current)), 0)
+	}
+
+	return time.Duration(math.Ceil(remaining + wait))
+}
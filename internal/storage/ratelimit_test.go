@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRateLimit_tokenBucket(t *testing.T) {
+	s := NewInMemory()
+	l := Limit{Algorithm: AlgorithmTokenBucket, Limit: 10, Window: 10 * time.Second}
+	now := time.Now()
+
+	r, err := RateLimit(context.TODO(), s, "test", l, 10, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Allowed || r.Remaining != 0 {
+		t.Errorf("RateLimit() = %+v, want allowed with 0 remaining", r)
+	}
+
+	r, err = RateLimit(context.TODO(), s, "test", l, 2, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Allowed || r.RetryAfter != 2*time.Second {
+		t.Errorf("RateLimit() = %+v, want denied with a retry after of 2s", r)
+	}
+
+	// one token is added every second
+	r, err = RateLimit(context.TODO(), s, "test", l, 2, now.Add(2*time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Allowed || r.Remaining != 0 {
+		t.Errorf("RateLimit() = %+v, want allowed with 0 remaining", r)
+	}
+
+	if _, err := RateLimit(context.TODO(), s, "test", l, 11, now); err != ErrCostExceedsLimit {
+		t.Errorf("RateLimit() error = %v, want %v", err, ErrCostExceedsLimit)
+	}
+}
+
+func TestRateLimit_slidingWindow(t *testing.T) {
+	s := NewInMemory()
+	l := Limit{Algorithm: AlgorithmSlidingWindow, Limit: 10, Window: 10 * time.Second}
+	start := time.Now().Truncate(l.Window)
+
+	r, err := RateLimit(context.TODO(), s, "test", l, 10, start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Allowed {
+		t.Errorf("RateLimit() = %+v, want allowed", r)
+	}
+
+	r, err = RateLimit(context.TODO(), s, "test", l, 1, start.Add(5*time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the next window starts in 5s and 1s later the previous window is weighted low enough
+	if r.Allowed || r.RetryAfter != 6*time.Second {
+		t.Errorf("RateLimit() = %+v, want denied with a retry after of 6s", r)
+	}
+
+	// half of the previous window overlaps the sliding window
+	r, err = RateLimit(context.TODO(), s, "test", l, 5, start.Add(15*time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Allowed || r.Remaining != 0 {
+		t.Errorf("RateLimit() = %+v, want allowed with 0 remaining", r)
+	}
+}
+
+func TestRateLimit_concurrent(t *testing.T) {
+	s := NewInMemory()
+	l := Limit{Algorithm: AlgorithmTokenBucket, Limit: 10, Window: time.Hour}
+	now := time.Now()
+
+	var allowed atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			r, err := RateLimit(context.TODO(), s, "test", l, 1, now)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			if r.Allowed {
+				allowed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if allowed.Load() != 10 {
+		t.Errorf("RateLimit() allowed %v requests, want %v", allowed.Load(), 10)
+	}
+}
@@ -0,0 +1,146 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+)
+
+// Set is a set of unique members stored as a single key. The members share the ttl of the key and every
+// change is written using the revision the set was read at so concurrent writers never lose a member.
+type Set map[string]struct{}
+
+// set decodes the set stored in the entry, a nil entry is an empty set.
+func set(e *Entry) (Set, error) {
+	s := make(Set)
+	if e == nil || len(e.Value) == 0 {
+		return s, nil
+	}
+
+	var members []string
+	if err := json.Unmarshal(e.Value, &members); err != nil {
+		return nil, err
+	}
+
+	for _, m := range members {
+		s[m] = struct{}{}
+	}
+
+	return s, nil
+}
+
+// Members returns the members of the set in sorted order.
+func (s Set) Members() []string {
+	members := make([]string, 0, len(s))
+	for m := range s {
+		members = append(members, m)
+	}
+
+	sort.Strings(members)
+
+	return members
+}
+
+// item encodes the set into an item with the ttl, an empty set is stored as an expired item so it is
+// removed from the store.
+func (s Set) item(ttl int64) (Item, error) {
+	if len(s) == 0 {
+		return Item{TTL: time.Now().Add(-time.Second).Unix()}, nil
+	}
+
+	b, err := json.Marshal(s.Members())
+	if err != nil {
+		return Item{}, err
+	}
+
+	return Item{Value: b, TTL: ttl}, nil
+}
+
+// SetGet returns the set and the ttl of the set. If the set does not exist the set is empty.
+func SetGet(ctx context.Context, s Store, key string) (Set, int64, error) {
+	e, err := s.Get(ctx, key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	members, err := set(e)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if e == nil {
+		return members, 0, nil
+	}
+
+	return members, e.TTL, nil
+}
+
+// SetAdd adds the members to the set and returns the number of members that were added. If the ttl is
+// not 0 it replaces the ttl of the set, otherwise the set keeps its ttl.
+func SetAdd(ctx context.Context, s Store, key string, members []string, ttl int64) (int, error) {
+	var added int
+
+	_, err := Mutate(ctx, s, key, func(e *Entry) (Item, error) {
+		current, err := set(e)
+		if err != nil {
+			return Item{}, err
+		}
+
+		added = 0
+		for _, m := range members {
+			if _, ok := current[m]; !ok {
+				current[m] = struct{}{}
+				added++
+			}
+		}
+
+		if added == 0 && ttl == 0 && e != nil {
+			return Item{}, errNoChange
+		}
+
+		expires := ttl
+		if expires == 0 && e != nil {
+			expires = e.TTL
+		}
+
+		return current.item(expires)
+	})
+	if err != nil && !errors.Is(err, errNoChange) {
+		return 0, err
+	}
+
+	return added, nil
+}
+
+// SetRemove removes the members from the set and returns the number of members that were removed.
+func SetRemove(ctx context.Context, s Store, key string, members []string) (int, error) {
+	var removed int
+
+	_, err := Mutate(ctx, s, key, func(e *Entry) (Item, error) {
+		current, err := set(e)
+		if err != nil {
+			return Item{}, err
+		}
+
+		removed = 0
+		for _, m := range members {
+			if _, ok := current[m]; ok {
+				delete(current, m)
+				removed++
+			}
+		}
+
+		if removed == 0 {
+			return Item{}, errNoChange
+		}
+
+		return current.item(e.TTL)
+	})
+	if err != nil && !errors.Is(err, errNoChange) {
+		return 0, err
+	}
+
+	return removed, nil
+}
@@ -0,0 +1,59 @@
+package storage
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestSetAdd(t *testing.T) {
+	s := NewInMemory()
+
+	added, err := SetAdd(context.TODO(), s, "test", []string{"b", "a", "b"}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added != 2 {
+		t.Errorf("SetAdd() = %v, want %v", added, 2)
+	}
+
+	added, err = SetAdd(context.TODO(), s, "test", []string{"a", "c"}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added != 1 {
+		t.Errorf("SetAdd() = %v, want %v", added, 1)
+	}
+
+	members, _, err := SetGet(context.TODO(), s, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(members.Members(), want) {
+		t.Errorf("SetGet() = %v, want %v", members.Members(), want)
+	}
+}
+
+func TestSetRemove(t *testing.T) {
+	s := NewInMemory()
+
+	if _, err := SetAdd(context.TODO(), s, "test", []string{"a", "b"}, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	removed, err := SetRemove(context.TODO(), s, "test", []string{"a", "missing"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removed != 1 {
+		t.Errorf("SetRemove() = %v, want %v", removed, 1)
+	}
+
+	if _, err := SetRemove(context.TODO(), s, "test", []string{"b"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if e, _ := s.Get(context.TODO(), "test"); e != nil {
+		t.Errorf("Get() = %v, want nil after the last member was removed", e)
+	}
+}
@@ -0,0 +1,214 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"slices"
+	"sort"
+)
+
+// SortedSet is a set of unique members with a score stored as a single key. Members are ordered by score
+// and members with the same score are ordered by the member. The members share the ttl of the key and
+// every change is written using the revision the sorted set was read at.
+type SortedSet map[string]float64
+
+// ScoredMember is a member of a sorted set and its score.
+type ScoredMember struct {
+	Member string
+	Score  float64
+}
+
+// sortedSet decodes the sorted set stored in the entry, a nil entry is an empty sorted set.
+func sortedSet(e *Entry) (SortedSet, error) {
+	z := make(SortedSet)
+	if e == nil || len(e.Value) == 0 {
+		return z, nil
+	}
+
+	if err := json.Unmarshal(e.Value, &z); err != nil {
+		return nil, err
+	}
+
+	return z, nil
+}
+
+// item encodes the sorted set into an item with the ttl.
+func (z SortedSet) item(ttl int64) (Item, error) {
+	b, err := json.Marshal(z)
+	if err != nil {
+		return Item{}, err
+	}
+
+	return Item{Value: b, TTL: ttl}, nil
+}
+
+// Sorted returns the members ordered by score, lowest first.
+func (z SortedSet) Sorted() []ScoredMember {
+	members := make([]ScoredMember, 0, len(z))
+	for m, score := range z {
+		members = append(members, ScoredMember{Member: m, Score: score})
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].Score != members[j].Score {
+			return members[i].Score < members[j].Score
+		}
+
+		return members[i].Member < members[j].Member
+	})
+
+	return members
+}
+
+// SortedSetGet returns the sorted set and the ttl of the sorted set. If the sorted set does not exist the
+// sorted set is empty.
+func SortedSetGet(ctx context.Context, s Store, key string) (SortedSet, int64, error) {
+	e, err := s.Get(ctx, key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	z, err := sortedSet(e)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if e == nil {
+		return z, 0, nil
+	}
+
+	return z, e.TTL, nil
+}
+
+// SortedSetAdd sets the score of the members and returns the number of members that were added. If the
+// ttl is not 0 it replaces the ttl of the sorted set, otherwise the sorted set keeps its ttl.
+func SortedSetAdd(ctx context.Context, s Store, key string, members map[string]float64, ttl int64) (int, error) {
+	var added int
+
+	_, err := Mutate(ctx, s, key, func(e *Entry) (Item, error) {
+		z, err := sortedSet(e)
+		if err != nil {
+			return Item{}, err
+		}
+
+		added = 0
+		for m, score := range members {
+			if _, ok := z[m]; !ok {
+				added++
+			}
+
+			z[m] = score
+		}
+
+		expires := ttl
+		if expires == 0 && e != nil {
+			expires = e.TTL
+		}
+
+		return z.item(expires)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return added, nil
+}
+
+// SortedSetIncrement adds the delta to the score of the member, a new member starts with a score of 0,
+// and returns the new score. If the sorted set does not exist it is created with the ttl.
+func SortedSetIncrement(ctx context.Context, s Store, key, member string, delta float64, ttl int64) (float64, error) {
+	var score float64
+
+	_, err := Mutate(ctx, s, key, func(e *Entry) (Item, error) {
+		z, err := sortedSet(e)
+		if err != nil {
+			return Item{}, err
+		}
+
+		score = z[member] + delta
+		if math.IsNaN(score) || math.IsInf(score, 0) {
+			return Item{}, ErrOverflow
+		}
+
+		z[member] = score
+
+		expires := ttl
+		if e != nil {
+			expires = e.TTL
+		}
+
+		return z.item(expires)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return score, nil
+}
+
+// SortedSetRangeByRank returns the members between the inclusive start and stop ranks, negative ranks
+// count from the end. If reverse is true the members are ranked from the highest score.
+func SortedSetRangeByRank(ctx context.Context, s Store, key string, start, stop int64, reverse bool) ([]ScoredMember, error) {
+	z, _, err := SortedSetGet(ctx, s, key)
+	if err != nil {
+		return nil, err
+	}
+
+	members := z.Sorted()
+	if reverse {
+		slices.Reverse(members)
+	}
+
+	from, to := bounds(len(members), start, stop)
+
+	return members[from:to], nil
+}
+
+// SortedSetRangeByScore returns the members with a score between the inclusive low and high scores
+// ordered by score. If reverse is true the members with the highest score are first.
+func SortedSetRangeByScore(ctx context.Context, s Store, key string, low, high float64, reverse bool) ([]ScoredMember, error) {
+	z, _, err := SortedSetGet(ctx, s, key)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []ScoredMember
+	for _, m := range z.Sorted() {
+		if m.Score >= low && m.Score <= high {
+			members = append(members, m)
+		}
+	}
+
+	if reverse {
+		slices.Reverse(members)
+	}
+
+	return members, nil
+}
+
+// SortedSetRank returns the rank of the member and its score, if the member does not exist the rank is
+// -1. If reverse is true the members are ranked from the highest score.
+func SortedSetRank(ctx context.Context, s Store, key, member string, reverse bool) (int, float64, error) {
+	z, _, err := SortedSetGet(ctx, s, key)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if _, ok := z[member]; !ok {
+		return -1, 0, nil
+	}
+
+	members := z.Sorted()
+	if reverse {
+		slices.Reverse(members)
+	}
+
+	for i, m := range members {
+		if m.Member == member {
+			return i, m.Score, nil
+		}
+	}
+
+	return -1, 0, nil
+}
@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestSortedSet(t *testing.T) {
+	s := NewInMemory()
+
+	added, err := SortedSetAdd(context.TODO(), s, "test", map[string]float64{"a": 10, "b": 20, "c": 30}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added != 3 {
+		t.Errorf("SortedSetAdd() = %v, want %v", added, 3)
+	}
+
+	score, err := SortedSetIncrement(context.TODO(), s, "test", "a", 15, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if score != 25 {
+		t.Errorf("SortedSetIncrement() = %v, want %v", score, 25)
+	}
+
+	tests := []struct {
+		name string
+		fn   func() ([]ScoredMember, error)
+		want []ScoredMember
+	}{
+		{
+			name: "by rank",
+			fn: func() ([]ScoredMember, error) {
+				return SortedSetRangeByRank(context.TODO(), s, "test", 0, -1, false)
+			},
+			want: []ScoredMember{{"b", 20}, {"a", 25}, {"c", 30}},
+		},
+		{
+			name: "by rank reversed",
+			fn: func() ([]ScoredMember, error) {
+				return SortedSetRangeByRank(context.TODO(), s, "test", 0, 1, true)
+			},
+			want: []ScoredMember{{"c", 30}, {"a", 25}},
+		},
+		{
+			name: "by score",
+			fn: func() ([]ScoredMember, error) {
+				return SortedSetRangeByScore(context.TODO(), s, "test", 21, 30, false)
+			},
+			want: []ScoredMember{{"a", 25}, {"c", 30}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	rank, score, err := SortedSetRank(context.TODO(), s, "test", "a", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rank != 1 || score != 25 {
+		t.Errorf("SortedSetRank() = %v, %v, want %v, %v", rank, score, 1, 25)
+	}
+
+	if rank, _, _ := SortedSetRank(context.TODO(), s, "test", "missing", false); rank != -1 {
+		t.Errorf("SortedSetRank() = %v, want %v", rank, -1)
+	}
+}
@@ -2,15 +2,59 @@ package storage
 
 import (
 	"context"
+	"errors"
+	"math"
+	"slices"
+	"strconv"
 	"time"
 )
 
+var (
+	// ErrKeyExists is returned when a key is created but already exists and has not expired.
+	ErrKeyExists = errors.New("key already exists")
+	// ErrKeyNotFound is returned when a key is required to exist but does not exist or has expired.
+	ErrKeyNotFound = errors.New("key not found")
+	// ErrRevisionMismatch is returned when a key is updated but the revision is no longer the latest.
+	ErrRevisionMismatch = errors.New("revision does not match the latest revision")
+	// ErrNotInteger is returned when an integer operation is performed on a value that is not an integer.
+	ErrNotInteger = errors.New("value is not an integer")
+	// ErrOverflow is returned when an integer operation would overflow an int64.
+	ErrOverflow = errors.New("increment or decrement would overflow")
+	// ErrValueTooLarge is returned when an item is larger than the store accepts, large values are stored
+	// in an ObjectStore instead.
+	ErrValueTooLarge = errors.New("value is too large")
+)
+
 // Item is a struct that holds the value and ttl of a key.
 // Since NATS does not natively support a TTL per key we need to store it in the value.
 // See this issue for more details https://github.com/nats-io/nats-server/issues/3251
 type Item struct {
 	Value []byte `json:"value"`
 	TTL   int64  `json:"ttl"`
+	// Sliding is the number of seconds the TTL is pushed forward to when the key is read, 0 means the
+	// TTL is fixed.
+	Sliding int64 `json:"sliding,omitempty"`
+	// SoftTTL is the unix time the value becomes stale, a stale value is still returned until the TTL so
+	// clients can serve it while the value is refreshed. 0 means the value is never stale.
+	SoftTTL int64 `json:"soft_ttl,omitempty"`
+	// Tags are used to find and remove the key along with other keys that have the same tag.
+	Tags []string `json:"tags,omitempty"`
+	// ContentType, ContentEncoding and Metadata describe the value to consumers of the key and are
+	// not used by the cache.
+	ContentType     string            `json:"content_type,omitempty"`
+	ContentEncoding string            `json:"content_encoding,omitempty"`
+	Metadata        map[string]string `json:"metadata,omitempty"`
+	// Flags are opaque to the cache and returned to memcached clients with the value.
+	Flags uint32 `json:"flags,omitempty"`
+	// Codec is the compression applied to the value when it was stored, see NewCompressed.
+	Codec Codec `json:"codec,omitempty"`
+	// KeyVersion is the version of the master key the value was encrypted with, 0 means the value is not
+	// encrypted, see NewEncrypted.
+	KeyVersion uint32 `json:"key_version,omitempty"`
+	// Object is the name of the object in the ObjectStore that holds the value when it is too large to
+	// store in the item, the value of the item is empty.
+	Object     string `json:"object,omitempty"`
+	ObjectSize uint64 `json:"object_size,omitempty"`
 }
 
 func (i Item) IsExpired() bool {
@@ -21,10 +65,163 @@ func (i Item) IsExpired() bool {
 	return i.TTL < time.Now().Unix()
 }
 
+// IsStale returns true if the soft TTL of the item has passed.
+func (i Item) IsStale() bool {
+	if i.SoftTTL == 0 {
+		return false
+	}
+
+	return i.SoftTTL < time.Now().Unix()
+}
+
+// HasTag returns true if the item has the tag.
+func (i Item) HasTag(tag string) bool {
+	return slices.Contains(i.Tags, tag)
+}
+
+// Entry is an Item along with the revision it was stored at. Revisions increase every time a key
+// is written and are used to perform conditional updates.
+type Entry struct {
+	Item
+	Revision uint64
+}
+
+// incr parses the value as a base 10 integer, adds the delta and returns the result. An empty value
+// is treated as 0 so a missing key can be incremented.
+func incr(value []byte, delta int64) (int64, error) {
+	var current int64
+	if len(value) > 0 {
+		v, err := strconv.ParseInt(string(value), 10, 64)
+		if err != nil {
+			return 0, ErrNotInteger
+		}
+
+		current = v
+	}
+
+	if (delta > 0 && current > math.MaxInt64-delta) || (delta < 0 && current < math.MinInt64-delta) {
+		return 0, ErrOverflow
+	}
+
+	return current + delta, nil
+}
+
 // Store is an interface that defines the methods needed to interact with a storage engine such as NATS KV
 type Store interface {
+	// Create stores the item only if the key does not exist or has expired and returns the new revision.
+	// If the key exists ErrKeyExists is returned.
+	Create(ctx context.Context, key string, i Item) (uint64, error)
 	Delete(ctx context.Context, key string) error
-	Get(ctx context.Context, key string) ([]byte, int64, error)
+	// Get returns the entry for the key, if the key does not exist or has expired the entry is nil.
+	Get(ctx context.Context, key string) (*Entry, error)
+	// GetRevision returns the entry stored at the revision of the key including expired items. If the
+	// revision is not a put of the key or is no longer kept in the history the entry is nil.
+	GetRevision(ctx context.Context, key string, revision uint64) (*Entry, error)
+	// GetAndDelete deletes the key only if it was not modified after it was read and returns the deleted
+	// entry. If the key does not exist or has expired the entry is nil.
+	GetAndDelete(ctx context.Context, key string) (*Entry, error)
+	// GetAndSet stores the item only if the key was not modified after it was read and returns the
+	// previous entry and the new revision. If the key did not exist or had expired the entry is nil.
+	GetAndSet(ctx context.Context, key string, i Item) (*Entry, uint64, error)
+	// History returns the changes to the key that are kept by the store, oldest first.
+	History(ctx context.Context, key string) ([]Event, error)
+	// Increment atomically adds the delta to the integer stored at the key and returns the new value and ttl.
+	// If the key does not exist it is created with the ttl provided, otherwise the existing ttl is kept.
+	Increment(ctx context.Context, key string, delta int64, ttl int64) (int64, int64, error)
+	// Keys returns the sorted keys starting with the prefix. Keys may have expired and callers should
+	// use Get to check.
+	Keys(ctx context.Context, prefix string) ([]string, error)
+	// Purge deletes the keys starting with the prefix.
 	Purge(ctx context.Context, prefix string) error
-	Set(ctx context.Context, key string, value []byte, ttl int64) error
+	// Touch sets the ttl of the key, in unix time or 0 to remove the expiration, without changing the value
+	// and returns the new revision. Setting the ttl also removes the sliding window so reads do not push
+	// the new expiration forward. If the key does not exist ErrKeyNotFound is returned.
+	Touch(ctx context.Context, key string, ttl int64) (uint64, error)
+	// Set stores the item regardless of the current value and returns the new revision.
+	Set(ctx context.Context, key string, i Item) (uint64, error)
+	// Update stores the item only if the latest revision of the key matches the revision provided and
+	// returns the new revision. If the revision does not match ErrRevisionMismatch is returned.
+	Update(ctx context.Context, key string, i Item, revision uint64) (uint64, error)
+	// Watch sends an event for every change to the key, or every key starting with the key when prefix
+	// is true, until the context is done.
+	Watch(ctx context.Context, key string, prefix bool) (<-chan Event, error)
+}
+
+// Replace stores the item only if the key exists and has not expired. If the key is modified between
+// reading the revision and storing the item the replace is retried.
+func Replace(ctx context.Context, s Store, key string, i Item) (uint64, error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		e, err := s.Get(ctx, key)
+		if err != nil {
+			return 0, err
+		}
+
+		if e == nil {
+			return 0, ErrKeyNotFound
+		}
+
+		revision, err := s.Update(ctx, key, i, e.Revision)
+		if errors.Is(err, ErrRevisionMismatch) {
+			continue
+		}
+
+		return revision, err
+	}
+}
+
+// Mutate reads the key and calls fn with the entry, or nil if the key does not exist or has expired, and
+// stores the item returned by fn using the revision the key was read at. If the key is modified before
+// the item is stored the key is read again and fn is called again. If fn returns an error nothing is
+// stored and the error is returned.
+func Mutate(ctx context.Context, s Store, key string, fn func(e *Entry) (Item, error)) (uint64, error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		e, err := s.Get(ctx, key)
+		if err != nil {
+			return 0, err
+		}
+
+		i, err := fn(e)
+		if err != nil {
+			return 0, err
+		}
+
+		var revision uint64
+		if e == nil {
+			revision, err = s.Create(ctx, key, i)
+		} else {
+			revision, err = s.Update(ctx, key, i, e.Revision)
+		}
+		if errors.Is(err, ErrKeyExists) || errors.Is(err, ErrRevisionMismatch) {
+			continue
+		}
+
+		return revision, err
+	}
+}
+
+// errNoChange is returned by the function passed to Mutate when nothing needs to be stored, callers
+// treat it as success.
+var errNoChange = errors.New("no change")
+
+// touch implements Store.Touch using Mutate.
+func touch(ctx context.Context, s Store, key string, ttl int64) (uint64, error) {
+	return Mutate(ctx, s, key, func(e *Entry) (Item, error) {
+		if e == nil {
+			return Item{}, ErrKeyNotFound
+		}
+
+		i := e.Item
+		i.TTL = ttl
+		i.Sliding = 0
+
+		return i, nil
+	})
 }
@@ -0,0 +1,41 @@
+package storage
+
+import (
+	"testing"
+	"time"
+)
+
+func TestItem_IsStale(t *testing.T) {
+	tests := []struct {
+		name string
+		item Item
+		want bool
+	}{
+		{
+			name: "should not be stale without a soft ttl",
+			item: Item{Value: []byte("test")},
+			want: false,
+		},
+		{
+			name: "should not be stale before the soft ttl",
+			item: Item{Value: []byte("test"), SoftTTL: time.Now().Add(time.Minute).Unix()},
+			want: false,
+		},
+		{
+			name: "should be stale after the soft ttl and before the ttl",
+			item: Item{Value: []byte("test"), SoftTTL: time.Now().Add(-time.Minute).Unix(), TTL: time.Now().Add(time.Minute).Unix()},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.item.IsStale(); got != tt.want {
+				t.Errorf("IsStale() = %v, want %v", got, tt.want)
+			}
+
+			if tt.item.IsExpired() {
+				t.Errorf("IsExpired() = true, want false")
+			}
+		})
+	}
+}
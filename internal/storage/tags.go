@@ -0,0 +1,71 @@
+package storage
+
+import (
+	"context"
+	"errors"
+)
+
+// tagPruneSize is the number of keys of an index that are checked each time a key is added, keys that
+// are gone or expired are removed so the index does not keep growing with keys that will never be
+// invalidated.
+const tagPruneSize = 16
+
+// TagAdd adds the key to the index of a tag, the index is a Set of the keys with the tag. The index never
+// expires since the ttl of a key can change after it was tagged, such as with Touch or a sliding
+// expiration. Instead a few of the other keys in the index are checked on every add and the keys that are
+// gone or expired are removed, every key that is left is removed when the tag is invalidated.
+func TagAdd(ctx context.Context, s Store, index, key string) error {
+	keys, _, err := SetGet(ctx, s, index)
+	if err != nil {
+		return err
+	}
+
+	// map iteration has no order so a different part of the index is checked each time
+	var gone []string
+	checked := 0
+	for k := range keys {
+		if checked == tagPruneSize {
+			break
+		}
+
+		if k == key {
+			continue
+		}
+
+		checked++
+
+		e, err := s.Get(ctx, k)
+		if err != nil {
+			return err
+		}
+
+		if e == nil {
+			gone = append(gone, k)
+		}
+	}
+
+	_, err = Mutate(ctx, s, index, func(e *Entry) (Item, error) {
+		keys, err := set(e)
+		if err != nil {
+			return Item{}, err
+		}
+
+		// indexes written with an expiration are kept from now on
+		if _, ok := keys[key]; ok && e.TTL == 0 && len(gone) == 0 {
+			return Item{}, errNoChange
+		}
+
+		for _, k := range gone {
+			delete(keys, k)
+		}
+
+		keys[key] = struct{}{}
+
+		return keys.item(0)
+	})
+	if err != nil && !errors.Is(err, errNoChange) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTagAdd(t *testing.T) {
+	s := NewInMemory()
+
+	for _, key := range []string{"a", "b"} {
+		if _, err := s.Set(context.TODO(), key, Item{Value: []byte(key)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// an index written with an expiration
+	item, err := Set{"a": {}}.item(time.Now().Add(time.Minute).Unix())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Set(context.TODO(), "tag", item); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []string{"a", "b", "b"} {
+		if err := TagAdd(context.TODO(), s, "tag", key); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	keys, ttl, err := SetGet(context.TODO(), s, "tag")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 {
+		t.Errorf("SetGet() has %v keys, want %v", len(keys), 2)
+	}
+	if ttl != 0 {
+		t.Errorf("SetGet() ttl = %v, want the index to never expire", ttl)
+	}
+}
+
+func TestTagAdd_prune(t *testing.T) {
+	s := NewInMemory()
+
+	if _, err := s.Set(context.TODO(), "live", Item{Value: []byte("live")}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Set(context.TODO(), "expired", Item{Value: []byte("expired"), TTL: time.Now().Unix() - 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := Set{"live": {}, "expired": {}, "deleted": {}}.item(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Set(context.TODO(), "tag", item); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := TagAdd(context.TODO(), s, "tag", "new"); err != nil {
+		t.Fatal(err)
+	}
+
+	keys, _, err := SetGet(context.TODO(), s, "tag")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := keys.Members(); len(got) != 2 || got[0] != "live" || got[1] != "new" {
+		t.Errorf("SetGet() = %v, want the keys that are gone removed", got)
+	}
+}
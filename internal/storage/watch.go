@@ -0,0 +1,154 @@
+package storage
+
+import (
+	"context"
+	"time"
+)
+
+// Operation is the type of change to a key sent to watchers.
+type Operation int
+
+const (
+	OperationPut Operation = iota
+	OperationDelete
+	OperationPurge
+	OperationExpire
+)
+
+func (o Operation) String() string {
+	switch o {
+	case OperationPut:
+		return "put"
+	case OperationDelete:
+		return "delete"
+	case OperationPurge:
+		return "purge"
+	case OperationExpire:
+		return "expire"
+	}
+
+	return "unknown"
+}
+
+// Event is a change to a key. The item is only set for puts.
+type Event struct {
+	Item
+	Key       string
+	Operation Operation
+	Revision  uint64
+	// Created is the time the change was stored, it is only set for events returned by History.
+	Created time.Time
+}
+
+// matches returns true if the key should be sent to a watcher of the key or prefix.
+func matches(key, watched string, prefix bool) bool {
+	if prefix {
+		return len(key) >= len(watched) && key[:len(watched)] == watched
+	}
+
+	return key == watched
+}
+
+// watchExpirations forwards the events and sends an expire event when a put with a ttl is not
+// followed by another change to the key before the ttl passes. Since NATS does not expire keys on
+// its own, a delete or purge of a key that already expired (such as the purge when an expired key
+// is read) is not forwarded.
+func watchExpirations(ctx context.Context, in <-chan Event) <-chan Event {
+	type expiry struct {
+		timer      *time.Timer
+		generation uint64
+	}
+
+	type fired struct {
+		key        string
+		generation uint64
+		revision   uint64
+	}
+
+	out := make(chan Event)
+
+	go func() {
+		defer close(out)
+
+		pending := make(map[string]expiry)
+		expired := make(map[string]bool)
+		fires := make(chan fired)
+
+		var generation uint64
+
+		defer func() {
+			for _, p := range pending {
+				p.timer.Stop()
+			}
+		}()
+
+		send := func(e Event) bool {
+			select {
+			case out <- e:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case e, ok := <-in:
+				if !ok {
+					return
+				}
+
+				if p, ok := pending[e.Key]; ok {
+					p.timer.Stop()
+					delete(pending, e.Key)
+				}
+
+				switch e.Operation {
+				case OperationPut:
+					delete(expired, e.Key)
+
+					if e.TTL > 0 {
+						generation++
+
+						f := fired{key: e.Key, generation: generation, revision: e.Revision}
+						pending[e.Key] = expiry{
+							generation: generation,
+							// items expire once the ttl is in the past, see Item.IsExpired
+							timer: time.AfterFunc(time.Until(time.Unix(e.TTL+1, 0)), func() {
+								select {
+								case fires <- f:
+								case <-ctx.Done():
+								}
+							}),
+						}
+					}
+				case OperationDelete, OperationPurge:
+					if expired[e.Key] {
+						delete(expired, e.Key)
+						continue
+					}
+				}
+
+				if !send(e) {
+					return
+				}
+			case f := <-fires:
+				// the key was changed after the timer fired
+				if p, ok := pending[f.key]; !ok || p.generation != f.generation {
+					continue
+				}
+
+				delete(pending, f.key)
+				expired[f.key] = true
+
+				if !send(Event{Key: f.key, Operation: OperationExpire, Revision: f.revision}) {
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}